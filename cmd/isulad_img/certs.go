@@ -0,0 +1,32 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-20
+
+package main
+
+import (
+	"github.com/containers/image/types"
+)
+
+// defaultCertsDir contains a directory for each registry named as host[:port],
+// with ca.crt, client.cert and client.key in it.
+const defaultCertsDir = "/etc/isulad/certs.d"
+
+// setupRegistryCerts lets the docker client load certificates of the registry
+// from the certs directory. The directory is read every time a client is
+// created, so changes take effect without restarting daemon.
+func setupRegistryCerts(gopts *globalOptions, sys *types.SystemContext) {
+	if sys.DockerCertPath != "" || gopts.CertsDir == "" {
+		return
+	}
+	sys.DockerPerHostCertDirPath = gopts.CertsDir
+}
@@ -0,0 +1,147 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containers/image/docker"
+	"github.com/containers/image/types"
+)
+
+func TestRegistryEndpoints(t *testing.T) {
+	cases := []struct {
+		image string
+		// directory of certificates under certs dir and address dialed
+		certDir string
+		address string
+	}{
+		{"docker.io/library/busybox:latest", "docker.io", "registry-1.docker.io:443"},
+		{"busybox", "docker.io", "registry-1.docker.io:443"},
+		{"quay.io/coreos/etcd:v3", "quay.io", "quay.io:443"},
+		{"registry.example.com:5000/app:1.0", "registry.example.com:5000", "registry.example.com:5000"},
+		{"localhost/app", "localhost", "localhost:443"},
+	}
+
+	for _, c := range cases {
+		dir, err := ioutil.TempDir("", "certs")
+		if err != nil {
+			t.Fatal(err)
+		}
+		gopts := &globalOptions{CertsDir: filepath.Join(dir, "certs.d")}
+
+		var dialed []string
+		sys := &types.SystemContext{
+			AuthFilePath:      filepath.Join(dir, "auth.json"),
+			RegistriesDirPath: dir,
+			DockerTransportHook: func(registry string, tr *http.Transport) error {
+				tr.Proxy = nil
+				tr.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+					dialed = append(dialed, addr)
+					return nil, errors.New("no network in test")
+				}
+				return nil
+			},
+		}
+		setupRegistryCerts(gopts, sys)
+
+		ref, err := docker.ParseReference("//" + c.image)
+		if err != nil {
+			t.Fatalf("%s: parse failed: %v", c.image, err)
+		}
+		src, err := ref.NewImageSource(context.Background(), sys)
+		if err != nil {
+			t.Fatalf("%s: new image source failed: %v", c.image, err)
+		}
+		src.GetManifest(context.Background(), nil)
+		src.Close()
+		if len(dialed) == 0 || dialed[0] != c.address {
+			t.Errorf("%s: dialed %v, want %s", c.image, dialed, c.address)
+		}
+
+		// A key without certificate is refused, which shows the directory is read
+		certDir := filepath.Join(gopts.CertsDir, c.certDir)
+		if err := os.MkdirAll(certDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(certDir, "client.key"), []byte("key"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ref.NewImageSource(context.Background(), sys); err == nil || !strings.Contains(err.Error(), "client.key") {
+			t.Errorf("%s: certificates in %s not loaded, got %v", c.image, certDir, err)
+		}
+
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSetupRegistryCerts(t *testing.T) {
+	sys := &types.SystemContext{DockerCertPath: "/explicit"}
+	setupRegistryCerts(&globalOptions{CertsDir: defaultCertsDir}, sys)
+	if sys.DockerPerHostCertDirPath != "" {
+		t.Errorf("cert path of the request should be kept, got certs dir %s", sys.DockerPerHostCertDirPath)
+	}
+
+	sys = &types.SystemContext{}
+	setupRegistryCerts(&globalOptions{}, sys)
+	if sys.DockerPerHostCertDirPath != "" {
+		t.Errorf("certs dir should not be set if not configured, got %s", sys.DockerPerHostCertDirPath)
+	}
+}
+
+func TestRegistryMirrors(t *testing.T) {
+	svc := &imageService{registries: []string{"docker.io", "http://mirror.example.com:5000", "https://hub.example.com"}}
+	cases := []struct {
+		image string
+		names []string
+	}{
+		{"busybox", []string{"docker.io/library/busybox:latest", "mirror.example.com:5000/library/busybox:latest",
+			"hub.example.com/library/busybox:latest"}},
+		{"library/busybox:1.0", []string{"docker.io/library/busybox:1.0", "mirror.example.com:5000/library/busybox:1.0",
+			"hub.example.com/library/busybox:1.0"}},
+		{"quay.io/coreos/etcd:v3", []string{"quay.io/coreos/etcd:v3"}},
+	}
+
+	for _, c := range cases {
+		images, err := svc.ParseImageNames(c.image)
+		if err != nil {
+			t.Fatalf("%s: parse failed: %v", c.image, err)
+		}
+		var names []string
+		for _, img := range images {
+			names = append(names, img.name)
+		}
+		if strings.Join(names, ",") != strings.Join(c.names, ",") {
+			t.Errorf("%s: resolved to %v, want %v", c.image, names, c.names)
+		}
+		// Only mirrors given with http:// skip TLS verification
+		for _, img := range images {
+			if img.secureSkipTLSVerify != strings.HasPrefix(img.name, "mirror.example.com") {
+				t.Errorf("%s: %s skips TLS verification %v", c.image, img.name, img.secureSkipTLSVerify)
+			}
+		}
+	}
+
+	if _, err := (&imageService{}).ParseImageNames("busybox"); err == nil {
+		t.Errorf("image without domain should fail without registries")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-18
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const defaultDaemonConfigFile = "/etc/isulad/isulad_img.json"
+
+// configDuration is a duration written as a string like "30s" in config file
+type configDuration time.Duration
+
+func (d *configDuration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	if v < 0 {
+		return fmt.Errorf("negative duration %s", s)
+	}
+	*d = configDuration(v)
+	return nil
+}
+
+func (d configDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// registryConfig is the settings of a single registry in daemon config file
+type registryConfig struct {
+	// MaxBytesPerSec limits the download rate from the registry, 0 means no limit
+	MaxBytesPerSec int64 `json:"max-bytes-per-sec,omitempty"`
+	// Proxy is the proxy URL for all requests to the registry, overrides global proxy settings
+	Proxy string `json:"proxy,omitempty"`
+	// ConnectTimeout and ReadTimeout override the global ones if not 0
+	ConnectTimeout configDuration `json:"connect-timeout,omitempty"`
+	ReadTimeout    configDuration `json:"read-timeout,omitempty"`
+	// CredentialStore overrides the global credential store for the registry
+	CredentialStore string `json:"credential-store,omitempty"`
+}
+
+// daemonConfig is the daemon config file, it is reloaded on SIGHUP
+type daemonConfig struct {
+	// MaxParallelDownloads limits layers downloaded in parallel by a pull, 0 means default
+	MaxParallelDownloads uint `json:"max-parallel-downloads,omitempty"`
+	// MaxBytesPerSec limits the total download rate of all pulls, 0 means no limit
+	MaxBytesPerSec int64 `json:"max-bytes-per-sec,omitempty"`
+	// HTTPProxy, HTTPSProxy and NoProxy work like the environment variables
+	// of the same name. Proxy environment of daemon is used if none of them is set.
+	HTTPProxy  string `json:"http-proxy,omitempty"`
+	HTTPSProxy string `json:"https-proxy,omitempty"`
+	NoProxy    string `json:"no-proxy,omitempty"`
+	// ConnectTimeout limits the time to establish a connection to registry
+	ConnectTimeout configDuration `json:"connect-timeout,omitempty"`
+	// ReadTimeout limits the time waiting for data from registry, 0 means no limit
+	ReadTimeout configDuration `json:"read-timeout,omitempty"`
+	// DNSOverrides resolves host names to the IP addresses instead of using DNS
+	DNSOverrides map[string]string `json:"dns-overrides,omitempty"`
+	// CredentialStore is where login saves credentials: "file" (auths.json, default),
+	// "keyring" (kernel keyring) or <name> of a docker-credential-<name> helper
+	CredentialStore string `json:"credential-store,omitempty"`
+	// Registries are per registry settings keyed by registry domain, e.g. docker.io
+	Registries map[string]registryConfig `json:"registries,omitempty"`
+	// EvictionHighWatermark enables eviction of unused images when usage of the
+	// filesystem of graph root reaches it, in bytes like "50GB" or percent of the
+	// filesystem like "85%".
+	// Images are evicted until usage is below EvictionLowWatermark, which is 90% of
+	// the high watermark if not set.
+	EvictionHighWatermark watermark `json:"eviction-high-watermark,omitempty"`
+	EvictionLowWatermark  watermark `json:"eviction-low-watermark,omitempty"`
+	// EvictionInterval is how often usage of graph root is checked, 1m if not set
+	EvictionInterval configDuration `json:"eviction-interval,omitempty"`
+	// ThinPoolExtendThreshold enables extending the thin pool of devicemapper when
+	// usage of its data or metadata space reaches it in percent like "80%", or
+	// when free space drops below it in bytes like "10GB". The pool is extended
+	// by ThinPoolExtendStep which is 10% of the space if not set. Sizes in bytes
+	// are for the data space, the metadata space uses the same ratio of its size.
+	ThinPoolExtendThreshold watermark `json:"thinpool-extend-threshold,omitempty"`
+	ThinPoolExtendStep      watermark `json:"thinpool-extend-step,omitempty"`
+	// ThinPoolExtendMax limits how much the thin pool grows over its size when
+	// the daemon started. If not set, pools of LVM are unlimited and pools on
+	// loop files are limited by free space of the filesystem holding the files.
+	ThinPoolExtendMax watermark `json:"thinpool-extend-max,omitempty"`
+	// ThinPoolMinFree blocks preparing containers while free data or metadata
+	// space of the thin pool is below it
+	ThinPoolMinFree watermark `json:"thinpool-min-free,omitempty"`
+	// ThinPoolCheckInterval is how often usage of the thin pool is checked, 10s if not set
+	ThinPoolCheckInterval configDuration `json:"thinpool-check-interval,omitempty"`
+	// AutoUsernsUser is the user whose subordinate IDs are allocated to containers
+	// requesting automatic user namespace mappings, "containers" if not set
+	AutoUsernsUser string `json:"auto-userns-user,omitempty"`
+}
+
+var (
+	gConfigLock sync.RWMutex
+	gConfig     = &daemonConfig{}
+)
+
+func (c *daemonConfig) validate() error {
+	if c.MaxBytesPerSec < 0 {
+		return fmt.Errorf("invalid max-bytes-per-sec %d", c.MaxBytesPerSec)
+	}
+	for _, p := range []string{c.HTTPProxy, c.HTTPSProxy} {
+		if err := validateProxy(p); err != nil {
+			return err
+		}
+	}
+	if err := validateCredentialStore(c.CredentialStore); err != nil {
+		return err
+	}
+	for host, ip := range c.DNSOverrides {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid IP address %q in dns-overrides of %s", ip, host)
+		}
+	}
+	for name, reg := range c.Registries {
+		if reg.MaxBytesPerSec < 0 {
+			return fmt.Errorf("invalid max-bytes-per-sec %d of registry %s", reg.MaxBytesPerSec, name)
+		}
+		if err := validateProxy(reg.Proxy); err != nil {
+			return fmt.Errorf("invalid registry %s: %v", name, err)
+		}
+		if err := validateCredentialStore(reg.CredentialStore); err != nil {
+			return fmt.Errorf("invalid registry %s: %v", name, err)
+		}
+	}
+	if c.EvictionLowWatermark.isSet() && !c.EvictionHighWatermark.isSet() {
+		return fmt.Errorf("eviction-low-watermark is set without eviction-high-watermark")
+	}
+	// Watermarks in different units can only be compared with filesystem size
+	if high, low := c.EvictionHighWatermark, c.EvictionLowWatermark; low.bytes > high.bytes && high.bytes != 0 ||
+		low.percent > high.percent && high.percent != 0 {
+		return fmt.Errorf("eviction-low-watermark is above eviction-high-watermark")
+	}
+	if c.ThinPoolExtendStep.isSet() && !c.ThinPoolExtendThreshold.isSet() {
+		return fmt.Errorf("thinpool-extend-step is set without thinpool-extend-threshold")
+	}
+	if c.ThinPoolExtendMax.isSet() && !c.ThinPoolExtendThreshold.isSet() {
+		return fmt.Errorf("thinpool-extend-max is set without thinpool-extend-threshold")
+	}
+	return nil
+}
+
+// registry returns settings of the registry, zero value if not configured
+func (c *daemonConfig) registry(name string) registryConfig {
+	if name == "index.docker.io" || name == "registry-1.docker.io" {
+		name = "docker.io"
+	}
+	return c.Registries[name]
+}
+
+func loadDaemonConfig(path string) (*daemonConfig, error) {
+	config := &daemonConfig{}
+
+	if err := checkJSONFileSize(path); err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("error parsing config file %s: %v", path, err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+
+	return config, nil
+}
+
+// getDaemonConfig returns current daemon config, it must not be modified
+func getDaemonConfig() *daemonConfig {
+	gConfigLock.RLock()
+	defer gConfigLock.RUnlock()
+	return gConfig
+}
+
+// reloadDaemonConfig loads the config file and applies it, current config is
+// kept if the file is invalid.
+func reloadDaemonConfig(path string) error {
+	config, err := loadDaemonConfig(path)
+	if err != nil {
+		return err
+	}
+
+	gConfigLock.Lock()
+	gConfig = config
+	gConfigLock.Unlock()
+
+	gBandwidth.apply(config)
+	logrus.Infof("Daemon config %s loaded", path)
+
+	return nil
+}
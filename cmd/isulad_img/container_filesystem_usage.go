@@ -16,7 +16,6 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"path"
 	"time"
 
 	"github.com/containers/storage"
@@ -28,21 +27,27 @@ var (
 	containerName2 string
 )
 
-func getContainerStorageFsInfo(store storage.Store, containerpath string) (*FilesystemUsage, error) {
-	rootPath := store.GraphRoot()
-	storageDriver := store.GraphDriverName()
-	containerPath := path.Join(rootPath, storageDriver, containerpath, "diff")
-
-	bytesUsed, inodesUsed, err := GetDiskUsageStats(containerPath)
+// getContainerStorageFsInfo gets usage of the RW layer from the graph driver,
+// which takes it from the project quota of the layer if one is set. Drivers
+// copying the image into the layer, like vfs, leave the image out of usage.
+func getContainerStorageFsInfo(store storage.Store, layerID string) (*FilesystemUsage, error) {
+	du, err := store.LayerDiskUsage(layerID)
 	if err != nil {
 		return nil, err
 	}
+	mountpoint := store.GraphRoot()
+	if layer, err := store.Layer(layerID); err == nil && layer.MountPoint != "" {
+		mountpoint = layer.MountPoint
+	}
 
 	usage := FilesystemUsage{
 		Timestamp:  time.Now().UnixNano(),
-		FsID:       &FilesystemIdentifier{Mountpoint: containerPath},
-		UsedBytes:  &UInt64Value{Value: bytesUsed},
-		InodesUsed: &UInt64Value{Value: inodesUsed},
+		FsID:       &FilesystemIdentifier{Mountpoint: mountpoint},
+		UsedBytes:  &UInt64Value{Value: uint64(du.Size)},
+		InodesUsed: &UInt64Value{Value: uint64(du.InodeCount)},
+	}
+	if du.Limit > 0 {
+		usage.LimitBytes = &UInt64Value{Value: uint64(du.Limit)}
 	}
 
 	return &usage, nil
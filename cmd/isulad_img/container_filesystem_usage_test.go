@@ -0,0 +1,63 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-26
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestContainerStorageFsInfo(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base", "top")
+	container, err := svc.store.CreateContainer("", nil, image.ID, "", "{}", nil)
+	if err != nil {
+		t.Fatalf("failed to create container: %v", err)
+	}
+
+	// The image is copied into the container layer by vfs, it is not usage
+	// of the container
+	usage, err := getContainerStorageFsInfo(svc.store, container.LayerID)
+	if err != nil {
+		t.Fatalf("failed to get usage of container: %v", err)
+	}
+	if usage.UsedBytes.Value != 0 || usage.InodesUsed.Value != 0 || usage.LimitBytes != nil {
+		t.Errorf("got usage %d bytes, %d inodes, limit %v of new container, want nothing used and no limit",
+			usage.UsedBytes.Value, usage.InodesUsed.Value, usage.LimitBytes)
+	}
+	if usage.FsID.Mountpoint != svc.store.GraphRoot() {
+		t.Errorf("got mountpoint %s of unmounted container, want graph root", usage.FsID.Mountpoint)
+	}
+
+	written := "written by the container"
+	file := filepath.Join(svc.store.GraphRoot(), "vfs", "dir", container.LayerID, "written")
+	if err := ioutil.WriteFile(file, []byte(written), 0644); err != nil {
+		t.Fatal(err)
+	}
+	usage, err = getContainerStorageFsInfo(svc.store, container.LayerID)
+	if err != nil {
+		t.Fatalf("failed to get usage of container: %v", err)
+	}
+	if usage.UsedBytes.Value != uint64(len(written)) || usage.InodesUsed.Value != 1 {
+		t.Errorf("got usage %d bytes, %d inodes, want %d bytes, 1 inode",
+			usage.UsedBytes.Value, usage.InodesUsed.Value, len(written))
+	}
+
+	if _, err := getContainerStorageFsInfo(svc.store, "unknown"); err == nil {
+		t.Errorf("expect error for unknown layer")
+	}
+}
@@ -13,7 +13,56 @@
 
 package main
 
-func containerList(gopts *globalOptions) (map[string]bool, error) {
+import (
+	"encoding/json"
+
+	"github.com/containers/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// containerInfo is a container with its runtime metadata
+type containerInfo struct {
+	ID       string
+	Names    []string
+	LayerID  string
+	Mounted  bool
+	Metadata storage.RuntimeContainerMetadata
+}
+
+// containerMetadata returns runtime metadata of the container, containers
+// created by other tools may have no metadata
+func containerMetadata(c *storage.Container) (storage.RuntimeContainerMetadata, error) {
+	var metadata storage.RuntimeContainerMetadata
+	if c.Metadata == "" {
+		return metadata, nil
+	}
+	err := json.Unmarshal([]byte(c.Metadata), &metadata)
+	return metadata, err
+}
+
+// podContainers returns containers of the pod, or all containers if podID is empty
+func podContainers(containers []storage.Container, podID string) []containerInfo {
+	var infos []containerInfo
+	for i := range containers {
+		c := &containers[i]
+		metadata, err := containerMetadata(c)
+		if err != nil {
+			logrus.Warnf("Failed to get metadata of container %s: %v", c.ID, err)
+		}
+		if podID != "" && (!metadata.Pod || metadata.PodID != podID) {
+			continue
+		}
+		infos = append(infos, containerInfo{
+			ID:       c.ID,
+			Names:    c.Names,
+			LayerID:  c.LayerID,
+			Metadata: metadata,
+		})
+	}
+	return infos
+}
+
+func containerList(gopts *globalOptions, podID string) ([]containerInfo, error) {
 	store, err := getStorageStore(gopts)
 	if err != nil {
 		return nil, err
@@ -24,18 +73,14 @@ func containerList(gopts *globalOptions) (map[string]bool, error) {
 		return nil, err
 	}
 
-	cs := make(map[string]bool)
-	for _, c := range containers {
-		mountCount, err := store.Mounted(c.ID)
+	infos := podContainers(containers, podID)
+	for i := range infos {
+		mountCount, err := store.Mounted(infos[i].ID)
 		if err != nil {
 			return nil, err
 		}
-		if mountCount > 0 {
-			cs[c.ID] = true
-		} else {
-			cs[c.ID] = false
-		}
+		infos[i].Mounted = mountCount > 0
 	}
 
-	return cs, err
+	return infos, nil
 }
@@ -0,0 +1,60 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/containers/storage"
+)
+
+func TestPodContainers(t *testing.T) {
+	container := func(id string, metadata *storage.RuntimeContainerMetadata) storage.Container {
+		c := storage.Container{ID: id}
+		if metadata != nil {
+			data, err := json.Marshal(metadata)
+			if err != nil {
+				t.Fatalf("failed to marshal metadata: %v", err)
+			}
+			c.Metadata = string(data)
+		}
+		return c
+	}
+	containers := []storage.Container{
+		container("a", &storage.RuntimeContainerMetadata{Pod: true, PodID: "pod1", PodName: "web", Attempt: 1}),
+		container("b", &storage.RuntimeContainerMetadata{Pod: true, PodID: "pod2"}),
+		container("c", &storage.RuntimeContainerMetadata{Pod: true, PodID: "pod1"}),
+		// Containers not in a pod have their own name as pod ID
+		container("pod1", &storage.RuntimeContainerMetadata{PodID: "pod1"}),
+		container("d", nil),
+		{ID: "e", Metadata: "invalid"},
+	}
+
+	if infos := podContainers(containers, ""); len(infos) != len(containers) {
+		t.Errorf("got %d containers without filter, want %d", len(infos), len(containers))
+	}
+
+	infos := podContainers(containers, "pod1")
+	if len(infos) != 2 || infos[0].ID != "a" || infos[1].ID != "c" {
+		t.Fatalf("got containers %v of pod1, want a and c", infos)
+	}
+	if infos[0].Metadata.PodName != "web" || infos[0].Metadata.Attempt != 1 {
+		t.Errorf("got metadata %+v of a, want pod name web and attempt 1", infos[0].Metadata)
+	}
+
+	if infos := podContainers(containers, "pod3"); len(infos) != 0 {
+		t.Errorf("got containers %v of pod3, want none", infos)
+	}
+}
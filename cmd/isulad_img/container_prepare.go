@@ -15,23 +15,26 @@ package main
 
 import (
 	"github.com/containers/image/types"
-	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 func containerPrepare(gopts *globalOptions, storageOpts map[string]string,
-	containerImageName string, containerName string, containerID string) (string, *v1.Image, error) {
+	containerImageName string, containerName string, containerID string, options *ContainerCreateOptions) (*ContainerSpec, error) {
 	var sopts map[string]string
 
+	if err := checkThinPoolSpace(); err != nil {
+		return nil, err
+	}
+
 	imageService, err := getImageService(gopts)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 
 	storageRuntimeService := getRuntimeService("", imageService)
 	if storageRuntimeService == nil {
-		return "", nil, errors.New("Failed to get storageRuntimeService")
+		return nil, errors.New("Failed to get storageRuntimeService")
 	}
 
 	// Get imageName and imageRef that are later requested in container status
@@ -41,7 +44,14 @@ func containerPrepare(gopts *globalOptions, storageOpts map[string]string,
 	)
 	imgBasicSpec, imgBasicSpecErr = imageService.GetOneImage(&types.SystemContext{}, containerImageName)
 	if imgBasicSpecErr != nil {
-		return "", nil, imgBasicSpecErr
+		return nil, imgBasicSpecErr
+	}
+	img, err := imageService.GetStore().Image(imgBasicSpec.ID)
+	if err != nil {
+		return nil, err
+	}
+	if reason, ok := isQuarantined(img); ok {
+		return nil, errors.Errorf("image %s is quarantined: %s", containerImageName, reason)
 	}
 
 	if storageOpts != nil {
@@ -53,9 +63,9 @@ func containerPrepare(gopts *globalOptions, storageOpts map[string]string,
 	containerInfo, err := storageRuntimeService.CreateContainer(&types.SystemContext{},
 		containerImageName, imgBasicSpec.ID,
 		containerName, containerID,
-		sopts)
+		sopts, options)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 	defer func() {
 		if err != nil {
@@ -66,5 +76,7 @@ func containerPrepare(gopts *globalOptions, storageOpts map[string]string,
 		}
 	}()
 
-	return containerInfo.MountPoint, containerInfo.Config, err
+	stampLastUsed(imageService.GetStore(), imgBasicSpec.ID)
+
+	return &containerInfo, err
 }
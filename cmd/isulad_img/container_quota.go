@@ -0,0 +1,73 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+
+	units "github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// parseQuotaSize parses size in the format of the size storage option
+func parseQuotaSize(size string) (uint64, error) {
+	v, err := units.RAMInBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	if v <= 0 {
+		return 0, fmt.Errorf("invalid size %s", size)
+	}
+	return uint64(v), nil
+}
+
+// containerSetQuota changes the size limit of the RW layer of the container,
+// and returns the new limit and current usage.
+func containerSetQuota(gopts *globalOptions, nameID string, size string) (*FilesystemUsage, error) {
+	limit, err := parseQuotaSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	imageService, err := getImageService(gopts)
+	if err != nil {
+		return nil, err
+	}
+	storageRuntimeService := getRuntimeService("", imageService)
+	if storageRuntimeService == nil {
+		return nil, errors.New("Failed to get storageRuntimeService")
+	}
+	layerID, err := storageRuntimeService.GetContainerLayerID(nameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get container %s layerid: %v", nameID, err)
+	}
+
+	store := imageService.GetStore()
+	usage, err := store.LayerDiskUsage(layerID)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(usage.Size) > limit {
+		return nil, fmt.Errorf("size %s is smaller than current usage %s of container %s",
+			units.BytesSize(float64(limit)), units.BytesSize(float64(usage.Size)), nameID)
+	}
+
+	if err := store.SetLayerQuota(layerID, limit); err != nil {
+		return nil, fmt.Errorf("failed to set size of container %s: %v", nameID, err)
+	}
+	logrus.Infof("Size of container %s is limited to %s", nameID, units.BytesSize(float64(limit)))
+
+	return getContainerStorageFsInfo(store, layerID)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseQuotaSize(t *testing.T) {
+	valid := map[string]uint64{
+		"1024": 1024,
+		"10k":  10 * 1024,
+		"10G":  10 * 1024 * 1024 * 1024,
+		"1gb":  1024 * 1024 * 1024,
+	}
+	for s, expected := range valid {
+		if size, err := parseQuotaSize(s); err != nil || size != expected {
+			t.Errorf("parseQuotaSize(%q) = %d, %v, expected %d", s, size, err, expected)
+		}
+	}
+	for _, s := range []string{"", "0", "-1G", "ten"} {
+		if _, err := parseQuotaSize(s); err == nil {
+			t.Errorf("parseQuotaSize(%q) should fail", s)
+		}
+	}
+}
+
+func TestContainerSetQuota(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	defer useTestImageService(svc)()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base")
+	container, err := svc.store.CreateContainer("", []string{"quota"}, image.ID, "", "{}", nil)
+	if err != nil {
+		t.Fatalf("failed to create container: %v", err)
+	}
+	file := filepath.Join(svc.store.GraphRoot(), "vfs", "dir", container.LayerID, "written")
+	if err := ioutil.WriteFile(file, make([]byte, 8192), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	gopts := &globalOptions{}
+	for _, c := range []struct {
+		name, size, err string
+	}{
+		{"quota", "ten", "invalid size"},
+		{"unknown", "1G", "failed to get container unknown layerid"},
+		{"quota", "4k", "smaller than current usage"},
+	} {
+		if _, err := containerSetQuota(gopts, c.name, c.size); err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("set size %s of %s returned %v, want error %q", c.size, c.name, err, c.err)
+		}
+	}
+
+	// The limit is set only if the filesystem of the test supports project quota
+	usage, err := containerSetQuota(gopts, "quota", "1G")
+	if err != nil {
+		if !strings.Contains(err.Error(), "failed to set size of container quota") {
+			t.Errorf("got unexpected error %v", err)
+		}
+	} else if usage.LimitBytes == nil || usage.LimitBytes.Value != 1<<30 {
+		t.Errorf("got usage %+v, want limit 1G", usage)
+	}
+}
@@ -15,8 +15,10 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 func containerRemove(gopts *globalOptions, idOrName string) error {
@@ -36,3 +38,37 @@ func containerRemove(gopts *globalOptions, idOrName string) error {
 	}
 	return err
 }
+
+// podRemove removes all containers of the pod, IDs of containers removed are
+// returned. It goes on removing other containers if one fails.
+func podRemove(gopts *globalOptions, podID string) ([]string, error) {
+	imageService, err := getImageService(gopts)
+	if err != nil {
+		return nil, err
+	}
+
+	storageRuntimeService := getRuntimeService("", imageService)
+	if storageRuntimeService == nil {
+		return nil, errors.New("Failed to get storageRuntimeService")
+	}
+
+	containers, err := imageService.GetStore().Containers()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed, failed []string
+	for _, c := range podContainers(containers, podID) {
+		if err := storageRuntimeService.RemoveContainer(c.ID); err != nil {
+			logrus.Errorf("Failed to remove container %s of pod %s: %v", c.ID, podID, err)
+			failed = append(failed, c.ID)
+			continue
+		}
+		removed = append(removed, c.ID)
+	}
+	if len(failed) > 0 {
+		return removed, fmt.Errorf("failed to remove containers %s of pod %s", strings.Join(failed, ","), podID)
+	}
+
+	return removed, nil
+}
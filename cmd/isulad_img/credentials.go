@@ -0,0 +1,522 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-21
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/containers/image/pkg/docker/config"
+	"github.com/containers/image/types"
+	"github.com/containers/storage/pkg/ioutils"
+	helperclient "github.com/docker/docker-credential-helpers/client"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// credentials are saved in auths.json encrypted with the local AES key
+	fileCredentialStore = "file"
+	// credentials are saved in the kernel user keyring of daemon
+	keyringCredentialStore = "keyring"
+
+	keyringKeyType   = "user"
+	keyringKeyPrefix = "isulad-img:"
+
+	// helperIndexFile is saved next to auths.json and records registries whose
+	// credentials are saved in each credential helper by us
+	helperIndexFile = "credential-helpers.json"
+
+	// username of identity tokens saved in credential helpers, the same as docker
+	identityTokenUsername = "<token>"
+)
+
+// credentialStore saves registry credentials. Any store name other than file
+// and keyring is a docker-credential-<name> helper.
+type credentialStore interface {
+	get(registry string) (types.DockerAuthConfig, error)
+	store(registry string, auth types.DockerAuthConfig) error
+	erase(registry string) error
+	// list returns usernames keyed by registry
+	list() (map[string]string, error)
+}
+
+// getCredentialStore returns the store selected for registry in daemon config
+func getCredentialStore(sys *types.SystemContext, registry string) credentialStore {
+	c := getDaemonConfig()
+	name := c.CredentialStore
+	if reg := c.registry(registry); reg.CredentialStore != "" {
+		name = reg.CredentialStore
+	}
+
+	return newCredentialStore(sys, name)
+}
+
+// credentialStoreNames returns names of all stores used in daemon config
+func credentialStoreNames() []string {
+	c := getDaemonConfig()
+	names := []string{fileCredentialStore}
+	seen := map[string]bool{"": true, fileCredentialStore: true}
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	add(c.CredentialStore)
+	for _, reg := range c.Registries {
+		add(reg.CredentialStore)
+	}
+	return names
+}
+
+func newCredentialStore(sys *types.SystemContext, name string) credentialStore {
+	switch name {
+	case "", fileCredentialStore:
+		return &fileStore{sys: sys}
+	case keyringCredentialStore:
+		return &keyringStore{}
+	default:
+		return &helperStore{
+			name:      name,
+			program:   helperclient.NewShellProgramFunc("docker-credential-" + name),
+			indexPath: helperIndexPath(sys),
+		}
+	}
+}
+
+func validateCredentialStore(name string) error {
+	if name == "" || name == fileCredentialStore || name == keyringCredentialStore {
+		return nil
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.') {
+			return fmt.Errorf("invalid credential store %q", name)
+		}
+	}
+	return nil
+}
+
+type fileStore struct {
+	sys *types.SystemContext
+}
+
+func (s *fileStore) get(registry string) (types.DockerAuthConfig, error) {
+	return config.GetAuthConfig(s.sys, registry)
+}
+
+func (s *fileStore) store(registry string, auth types.DockerAuthConfig) error {
+	return config.SetAuthConfig(s.sys, registry, auth)
+}
+
+func (s *fileStore) erase(registry string) error {
+	return config.RemoveAuthentication(s.sys, registry)
+}
+
+func (s *fileStore) list() (map[string]string, error) {
+	return config.ListAuthentications(s.sys)
+}
+
+// helperStore saves credentials in a docker-credential-<name> helper. The
+// helper may be shared with other tools, so only registries recorded in the
+// index are listed or erased by logout of all registries.
+type helperStore struct {
+	name      string
+	program   helperclient.ProgramFunc
+	indexPath string
+}
+
+// gHelperIndexLock serializes updates of the credential helper index
+var gHelperIndexLock sync.Mutex
+
+func helperIndexPath(sys *types.SystemContext) string {
+	authFile := defaultAuthFilePath()
+	if sys != nil && sys.AuthFilePath != "" {
+		authFile = sys.AuthFilePath
+	}
+	return filepath.Join(filepath.Dir(authFile), helperIndexFile)
+}
+
+// readHelperIndex returns registries keyed by credential helper name
+func readHelperIndex(path string) (map[string][]string, error) {
+	index := make(map[string][]string)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %v", path, err)
+	}
+	return index, nil
+}
+
+// updateHelperIndex adds registry to or removes it from the index of helper
+func (s *helperStore) updateHelperIndex(registry string, add bool) error {
+	gHelperIndexLock.Lock()
+	defer gHelperIndexLock.Unlock()
+
+	index, err := readHelperIndex(s.indexPath)
+	if err != nil {
+		return err
+	}
+	var registries []string
+	for _, r := range index[s.name] {
+		if r != registry {
+			registries = append(registries, r)
+		}
+	}
+	if add {
+		registries = append(registries, registry)
+		sort.Strings(registries)
+	}
+	if len(registries) == 0 {
+		delete(index, s.name)
+	} else {
+		index[s.name] = registries
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.indexPath), 0700); err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(s.indexPath, data, 0600)
+}
+
+func (s *helperStore) get(registry string) (types.DockerAuthConfig, error) {
+	creds, err := helperclient.Get(s.program, registry)
+	if err != nil {
+		if credentials.IsErrCredentialsNotFound(err) {
+			return types.DockerAuthConfig{}, nil
+		}
+		return types.DockerAuthConfig{}, err
+	}
+	if creds.Username == identityTokenUsername {
+		return types.DockerAuthConfig{IdentityToken: creds.Secret}, nil
+	}
+	return types.DockerAuthConfig{Username: creds.Username, Password: creds.Secret}, nil
+}
+
+func (s *helperStore) store(registry string, auth types.DockerAuthConfig) error {
+	creds := &credentials.Credentials{
+		ServerURL: registry,
+		Username:  auth.Username,
+		Secret:    auth.Password,
+	}
+	if auth.RegistryToken != "" {
+		return fmt.Errorf("registry token of %s can not be saved in credential helper", registry)
+	}
+	if auth.IdentityToken != "" {
+		creds.Username = identityTokenUsername
+		creds.Secret = auth.IdentityToken
+	}
+	if err := helperclient.Store(s.program, creds); err != nil {
+		return err
+	}
+	return s.updateHelperIndex(registry, true)
+}
+
+func (s *helperStore) erase(registry string) error {
+	err := helperclient.Erase(s.program, registry)
+	notFound := err != nil && credentials.IsErrCredentialsNotFound(err)
+	if err != nil && !notFound {
+		return err
+	}
+	if err := s.updateHelperIndex(registry, false); err != nil {
+		return err
+	}
+	if notFound {
+		return config.ErrNotLoggedIn
+	}
+	return nil
+}
+
+// list returns only registries saved by us, others in the helper may belong
+// to other tools
+func (s *helperStore) list() (map[string]string, error) {
+	gHelperIndexLock.Lock()
+	index, err := readHelperIndex(s.indexPath)
+	gHelperIndexLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(index[s.name]) == 0 {
+		return map[string]string{}, nil
+	}
+
+	all, err := helperclient.List(s.program)
+	if err != nil {
+		return nil, err
+	}
+	logins := make(map[string]string)
+	for _, registry := range index[s.name] {
+		if username, ok := all[registry]; ok {
+			logins[registry] = username
+		}
+	}
+	return logins, nil
+}
+
+type keyringStore struct{}
+
+func (s *keyringStore) search(registry string) (int, error) {
+	id, err := unix.KeyctlSearch(unix.KEY_SPEC_USER_KEYRING, keyringKeyType, keyringKeyPrefix+registry, 0)
+	if err == unix.ENOKEY {
+		return 0, config.ErrNotLoggedIn
+	}
+	return id, err
+}
+
+// keyringPayload is saved in keyring as JSON
+type keyringPayload struct {
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+func (s *keyringStore) get(registry string) (types.DockerAuthConfig, error) {
+	id, err := s.search(registry)
+	if err == config.ErrNotLoggedIn {
+		return types.DockerAuthConfig{}, nil
+	}
+	if err != nil {
+		return types.DockerAuthConfig{}, fmt.Errorf("error searching keyring for %s: %v", registry, err)
+	}
+
+	size, err := unix.KeyctlBuffer(unix.KEYCTL_READ, id, nil, 0)
+	if err != nil {
+		return types.DockerAuthConfig{}, fmt.Errorf("error reading keyring for %s: %v", registry, err)
+	}
+	buf := make([]byte, size)
+	if _, err := unix.KeyctlBuffer(unix.KEYCTL_READ, id, buf, 0); err != nil {
+		return types.DockerAuthConfig{}, fmt.Errorf("error reading keyring for %s: %v", registry, err)
+	}
+
+	var payload keyringPayload
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		// Keys saved before tokens were supported have only base64 encoded username:password
+		payload = keyringPayload{Auth: string(buf)}
+	}
+	auth := types.DockerAuthConfig{
+		IdentityToken: payload.IdentityToken,
+		RegistryToken: payload.RegistryToken,
+	}
+	if payload.Auth != "" {
+		if auth.Username, auth.Password, err = decodeAuth(payload.Auth); err != nil {
+			return types.DockerAuthConfig{}, fmt.Errorf("error decoding keyring for %s: %v", registry, err)
+		}
+	}
+	return auth, nil
+}
+
+func (s *keyringStore) store(registry string, auth types.DockerAuthConfig) error {
+	payload := keyringPayload{
+		IdentityToken: auth.IdentityToken,
+		RegistryToken: auth.RegistryToken,
+	}
+	if auth.Username != "" || auth.Password != "" {
+		payload.Auth = base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + auth.Password))
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	// The key with the same description is updated in place
+	if _, err := unix.AddKey(keyringKeyType, keyringKeyPrefix+registry, data, unix.KEY_SPEC_USER_KEYRING); err != nil {
+		return fmt.Errorf("error adding key of %s to keyring: %v", registry, err)
+	}
+	return nil
+}
+
+func (s *keyringStore) erase(registry string) error {
+	id, err := s.search(registry)
+	if err != nil {
+		return err
+	}
+	if _, err := unix.KeyctlInt(unix.KEYCTL_UNLINK, id, unix.KEY_SPEC_USER_KEYRING, 0, 0); err != nil {
+		return fmt.Errorf("error removing key of %s from keyring: %v", registry, err)
+	}
+	return nil
+}
+
+func (s *keyringStore) list() (map[string]string, error) {
+	// Reading a keyring returns IDs of keys in it as 32 bits integers in host
+	// byte order
+	size, err := unix.KeyctlBuffer(unix.KEYCTL_READ, unix.KEY_SPEC_USER_KEYRING, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error reading keyring: %v", err)
+	}
+	buf := make([]byte, size)
+	if size, err = unix.KeyctlBuffer(unix.KEYCTL_READ, unix.KEY_SPEC_USER_KEYRING, buf, 0); err != nil {
+		return nil, fmt.Errorf("error reading keyring: %v", err)
+	}
+
+	logins := make(map[string]string)
+	for i := 0; i+4 <= size && i+4 <= len(buf); i += 4 {
+		id := int(*(*int32)(unsafe.Pointer(&buf[i])))
+		// Description is "type;uid;gid;perm;description"
+		desc, err := unix.KeyctlString(unix.KEYCTL_DESCRIBE, id)
+		if err != nil {
+			continue
+		}
+		parts := strings.SplitN(desc, ";", 5)
+		if len(parts) != 5 || parts[0] != keyringKeyType || !strings.HasPrefix(parts[4], keyringKeyPrefix) {
+			continue
+		}
+		registry := strings.TrimPrefix(parts[4], keyringKeyPrefix)
+		auth, err := s.get(registry)
+		if err != nil {
+			// Keep listing other logins, as when the key can not be described
+			logrus.Warnf("Failed to read credentials of %s from keyring: %v", registry, err)
+			continue
+		}
+		logins[registry] = loginUsername(auth)
+	}
+
+	return logins, nil
+}
+
+// setupCredentials loads credentials of registry into sys if they are kept
+// outside auths.json, the docker client reads auths.json by itself.
+func setupCredentials(sys *types.SystemContext, registry string) error {
+	store := getCredentialStore(sys, registry)
+	if _, ok := store.(*fileStore); ok || sys.DockerAuthConfig != nil {
+		return nil
+	}
+
+	auth, err := store.get(registry)
+	if err != nil {
+		return fmt.Errorf("error getting credentials of %s: %v", registry, err)
+	}
+	if auth == (types.DockerAuthConfig{}) {
+		return nil
+	}
+	sys.DockerAuthConfig = &auth
+	return nil
+}
+
+// loginUsername returns the username to show for auth, tokens have no username
+func loginUsername(auth types.DockerAuthConfig) string {
+	if auth.Username == "" && (auth.IdentityToken != "" || auth.RegistryToken != "") {
+		return identityTokenUsername
+	}
+	return auth.Username
+}
+
+// normalizeCredentialRegistry maps registry host names used by the docker
+// client back to the name used at login
+func normalizeCredentialRegistry(registry string) string {
+	if registry == "registry-1.docker.io" || registry == "index.docker.io" {
+		return "docker.io"
+	}
+	return registry
+}
+
+// saveIdentityToken saves the identity token rotated by token service of
+// registry, other credentials of the registry are kept.
+func saveIdentityToken(sys *types.SystemContext, registry, identityToken string) {
+	registry = normalizeCredentialRegistry(registry)
+	store := getCredentialStore(sys, registry)
+	auth, err := store.get(registry)
+	if err != nil {
+		logrus.Warnf("Failed to get credentials of %s to update identity token: %v", registry, err)
+		return
+	}
+	if auth.IdentityToken == "" {
+		// Token is not from the store, e.g. given in pull request
+		return
+	}
+	auth.IdentityToken = identityToken
+	if err := store.store(registry, auth); err != nil {
+		logrus.Warnf("Failed to save identity token of %s: %v", registry, err)
+		return
+	}
+	logrus.Infof("Identity token of %s refreshed", registry)
+}
+
+// loginInfo is a registry logged in, it never contains secrets
+type loginInfo struct {
+	server   string
+	username string
+	store    string
+}
+
+// listLogins returns registries logged in of all credential stores in use
+func listLogins(sys *types.SystemContext) ([]loginInfo, error) {
+	var logins []loginInfo
+	for _, name := range credentialStoreNames() {
+		l, err := newCredentialStore(sys, name).list()
+		if err != nil {
+			return nil, fmt.Errorf("error listing credential store %s: %v", name, err)
+		}
+		for server, username := range l {
+			logins = append(logins, loginInfo{server: server, username: username, store: name})
+		}
+	}
+	sort.Slice(logins, func(i, j int) bool {
+		if logins[i].server != logins[j].server {
+			return logins[i].server < logins[j].server
+		}
+		return logins[i].store < logins[j].store
+	})
+
+	return logins, nil
+}
+
+// logoutAll removes credentials of all registries from all credential stores
+// in use, returns the registries logged out. Credentials saved in credential
+// helpers by other tools are kept.
+func logoutAll(sys *types.SystemContext) ([]string, error) {
+	logins, err := listLogins(sys)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []string
+	for _, l := range logins {
+		if l.store == fileCredentialStore {
+			continue
+		}
+		if err := newCredentialStore(sys, l.store).erase(l.server); err != nil && err != config.ErrNotLoggedIn {
+			return servers, fmt.Errorf("error removing credentials of %s from %s: %v", l.server, l.store, err)
+		}
+		servers = append(servers, l.server)
+	}
+	// Credentials in auths.json are removed at once
+	if err := config.RemoveAllAuthentication(sys); err != nil {
+		return servers, err
+	}
+	for _, l := range logins {
+		if l.store == fileCredentialStore {
+			servers = append(servers, l.server)
+		}
+	}
+
+	return servers, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/image/types"
+)
+
+// fakeHelper is a docker-credential helper saving each credential in a file
+// named by the server in the creds directory next to it
+const fakeHelper = `#!/bin/sh
+dir=$(dirname "$0")/creds
+mkdir -p "$dir"
+case "$1" in
+store)
+	in=$(cat)
+	server=$(echo "$in" | sed 's/.*"ServerURL":"\([^"]*\)".*/\1/')
+	user=$(echo "$in" | sed 's/.*"Username":"\([^"]*\)".*/\1/')
+	secret=$(echo "$in" | sed 's/.*"Secret":"\([^"]*\)".*/\1/')
+	echo "$user $secret" > "$dir/$server"
+	;;
+get)
+	read server
+	if [ ! -f "$dir/$server" ]; then
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	read user secret < "$dir/$server"
+	echo "{\"ServerURL\":\"$server\",\"Username\":\"$user\",\"Secret\":\"$secret\"}"
+	;;
+erase)
+	read server
+	if [ ! -f "$dir/$server" ]; then
+		echo "credentials not found in native keychain"
+		exit 1
+	fi
+	rm -f "$dir/$server"
+	;;
+list)
+	sep=""
+	printf "{"
+	for f in "$dir"/*; do
+		[ -f "$f" ] || continue
+		read user secret < "$f"
+		printf '%s"%s":"%s"' "$sep" "$(basename "$f")" "$user"
+		sep=","
+	done
+	printf "}"
+	;;
+esac
+`
+
+func TestHelperStoreOwnCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "credentials-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "docker-credential-fake"), []byte(fakeHelper), 0700); err != nil {
+		t.Fatal(err)
+	}
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath)
+	defer os.Setenv("PATH", oldPath)
+
+	// Credentials saved in the helper by another tool
+	if err := os.MkdirAll(filepath.Join(dir, "creds"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "creds", "other.io"), []byte("other secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	oldConfig := gConfig
+	gConfig = &daemonConfig{CredentialStore: "fake"}
+	defer func() { gConfig = oldConfig }()
+
+	sys := &types.SystemContext{AuthFilePath: filepath.Join(dir, "auths.json")}
+	store := newCredentialStore(sys, "fake")
+	if err := store.store("r.io", types.DockerAuthConfig{Username: "user", Password: "secret"}); err != nil {
+		t.Fatalf("failed to store credentials: %v", err)
+	}
+	if auth, err := store.get("r.io"); err != nil || auth.Username != "user" || auth.Password != "secret" {
+		t.Errorf("got credentials %+v, %v, want user and secret", auth, err)
+	}
+
+	logins, err := store.list()
+	if err != nil || len(logins) != 1 || logins["r.io"] != "user" {
+		t.Errorf("got logins %v, %v, want only r.io", logins, err)
+	}
+
+	servers, err := logoutAll(sys)
+	if err != nil {
+		t.Fatalf("failed to logout all: %v", err)
+	}
+	if len(servers) != 1 || servers[0] != "r.io" {
+		t.Errorf("got servers %v logged out, want only r.io", servers)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "creds", "r.io")); !os.IsNotExist(err) {
+		t.Errorf("credentials of r.io should be erased from helper: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "creds", "other.io")); err != nil {
+		t.Errorf("credentials of other.io saved by another tool should be kept: %v", err)
+	}
+	if logins, err := store.list(); err != nil || len(logins) != 0 {
+		t.Errorf("got logins %v, %v after logout, want none", logins, err)
+	}
+}
@@ -37,6 +37,22 @@ func daemonHandler(c *cli.Context) error {
 		return err
 	}
 	gopts.Daemon = true
+	gopts.CertsDir = c.String("certs-dir")
+	gopts.PartialBlobMaxAge = c.Duration("partial-blob-max-age")
+	gopts.IntegrationCheck = integrationCheckOptions{
+		Workers: c.Int("check-workers"),
+		Fast:    c.Bool("fast-check"),
+	}
+	gopts.RetryPolicy = retryPolicy{
+		MaxAttempts: c.Int("pull-max-attempts"),
+		Backoff:     c.Duration("pull-retry-backoff"),
+		MaxBackoff:  c.Duration("pull-retry-max-backoff"),
+	}
+
+	configFile := c.String("config-file")
+	if err := reloadDaemonConfig(configFile); err != nil {
+		return err
+	}
 
 	// Only one instance is allowed
 	if err2 := newInfoFile(defaultInfoFile, address); err2 != nil {
@@ -50,10 +66,19 @@ func daemonHandler(c *cli.Context) error {
 		return err
 	}
 	getRuntimeService("", isrv)
+	startEvictionController(isrv)
+	if err := startThinPoolMonitor(isrv.GetStore()); err != nil {
+		return err
+	}
+
+	cleanupPartialBlobs(partialBlobStagingDir(gopts), gopts.PartialBlobMaxAge)
 
 	return startGrpcService(daemonOptions{
-		Address: address,
-		gopts:   gopts,
+		Address:            address,
+		ConfigFile:         configFile,
+		gopts:              gopts,
+		ShutdownTimeout:    c.Duration("shutdown-timeout"),
+		MaxConcurrentPulls: c.Int("max-concurrent-pulls"),
 	})
 }
 
@@ -80,5 +105,53 @@ var daemonCmd = cli.Command{
 			Name:  "use-decrypted-key",
 			Usage: "Use decrypted private key by default (defaults to true)",
 		},
+		cli.StringFlag{
+			Name:  "config-file",
+			Value: defaultDaemonConfigFile,
+			Usage: "daemon config file, reloaded on SIGHUP",
+		},
+		cli.StringFlag{
+			Name:  "certs-dir",
+			Value: defaultCertsDir,
+			Usage: "directory of per registry certificates, e.g. <certs-dir>/<registry>/ca.crt",
+		},
+		cli.DurationFlag{
+			Name:  "shutdown-timeout",
+			Value: defaultShutdownTimeout,
+			Usage: "time to wait for running pulls, loads and exports when shutting down",
+		},
+		cli.IntFlag{
+			Name:  "max-concurrent-pulls",
+			Value: 0,
+			Usage: "maximum number of distinct images pulled at the same time, 0 means no limit",
+		},
+		cli.DurationFlag{
+			Name:  "partial-blob-max-age",
+			Value: defaultPartialBlobMaxAge,
+			Usage: "remove partially downloaded blobs not updated for this duration",
+		},
+		cli.IntFlag{
+			Name:  "check-workers",
+			Usage: "number of layers checked in parallel by image integrity check, number of CPUs if 0",
+		},
+		cli.BoolFlag{
+			Name:  "fast-check",
+			Usage: "check only metadata of layers in image integrity check, contents are checked in background",
+		},
+		cli.IntFlag{
+			Name:  "pull-max-attempts",
+			Value: defaultRetryMaxAttempts,
+			Usage: "maximum attempts to pull an image from a registry on transient failures",
+		},
+		cli.DurationFlag{
+			Name:  "pull-retry-backoff",
+			Value: defaultRetryBackoff,
+			Usage: "initial backoff before retrying a failed pull, doubled on each retry",
+		},
+		cli.DurationFlag{
+			Name:  "pull-retry-max-backoff",
+			Value: defaultRetryMaxBackoff,
+			Usage: "maximum backoff before retrying a failed pull",
+		},
 	},
 }
@@ -0,0 +1,261 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// imageDiskUsage is the disk usage of an image
+type imageDiskUsage struct {
+	ID       string   `json:"id"`
+	RepoTags []string `json:"repo_tags,omitempty"`
+	// Size is the total size of the image
+	Size int64 `json:"size"`
+	// SharedSize is the size of layers shared with other images
+	SharedSize int64 `json:"shared_size"`
+	// UniqueSize is the size freed if only this image is removed
+	UniqueSize int64 `json:"unique_size"`
+	// Containers is the number of containers using the image
+	Containers int `json:"containers"`
+}
+
+// containerDiskUsage is the disk usage of the RW layer of a container
+type containerDiskUsage struct {
+	ID      string   `json:"id"`
+	Names   []string `json:"names,omitempty"`
+	ImageID string   `json:"image_id"`
+	LayerID string   `json:"layer_id"`
+	Size    int64    `json:"size"`
+}
+
+// layerDiskUsage is the disk usage of a layer used by no image or container
+type layerDiskUsage struct {
+	ID   string `json:"id"`
+	Size int64  `json:"size"`
+}
+
+type diskUsageResponse struct {
+	Images         []imageDiskUsage     `json:"images,omitempty"`
+	Containers     []containerDiskUsage `json:"containers,omitempty"`
+	DanglingLayers []layerDiskUsage     `json:"dangling_layers,omitempty"`
+	// ImagesSize counts layers shared by images only once
+	ImagesSize         int64 `json:"images_size"`
+	ContainersSize     int64 `json:"containers_size"`
+	DanglingLayersSize int64 `json:"dangling_layers_size"`
+	// ReclaimableSize is the size freed by removing all images not used by
+	// any container and all dangling layers
+	ReclaimableSize int64 `json:"reclaimable_size"`
+}
+
+// layerUsage is how layers are referenced by images and containers
+type layerUsage struct {
+	// refs is the number of images referencing the layer
+	refs map[string]int
+	// inUse is set for layers of images used by containers
+	inUse map[string]bool
+	// dangling are layers referenced by no image nor container
+	dangling []string
+}
+
+// classifyLayers finds how layers are referenced, imageLayers are layers of
+// every image and imageContainers are number of containers of every image.
+func classifyLayers(layers []string, imageLayers map[string][]string, imageContainers map[string]int, containerLayers []string) layerUsage {
+	usage := layerUsage{refs: make(map[string]int), inUse: make(map[string]bool)}
+	for imageID, ids := range imageLayers {
+		for _, id := range ids {
+			usage.refs[id]++
+			if imageContainers[imageID] > 0 {
+				usage.inUse[id] = true
+			}
+		}
+	}
+	referenced := make(map[string]bool)
+	for _, id := range containerLayers {
+		referenced[id] = true
+	}
+	for _, id := range layers {
+		if usage.refs[id] == 0 && !referenced[id] {
+			usage.dangling = append(usage.dangling, id)
+		}
+	}
+	return usage
+}
+
+// layerSize returns the size of the layer, it is computed if not recorded
+func layerSize(store storage.Store, id string) (int64, error) {
+	size, err := store.LayerSize(id)
+	if err != nil {
+		return -1, err
+	}
+	if size < 0 {
+		return store.DiffSize("", id)
+	}
+	return size, nil
+}
+
+func getDiskUsage(store storage.Store) (*diskUsageResponse, error) {
+	layers, err := store.Layers()
+	if err != nil {
+		return nil, err
+	}
+	images, err := store.Images()
+	if err != nil {
+		return nil, err
+	}
+	containers, err := store.Containers()
+	if err != nil {
+		return nil, err
+	}
+
+	var layerIDs, containerLayers []string
+	for _, layer := range layers {
+		layerIDs = append(layerIDs, layer.ID)
+	}
+	imageContainers := make(map[string]int)
+	for _, container := range containers {
+		imageContainers[container.ImageID]++
+		containerLayers = append(containerLayers, container.LayerID)
+	}
+	layersOfImages, broken := imageLayerMap(store, images)
+	for id, err := range broken {
+		logrus.Warnf("Disk usage of image %s is not accurate: %v", id, err)
+	}
+	usage := classifyLayers(layerIDs, layersOfImages, imageContainers, containerLayers)
+
+	sizes := make(map[string]int64)
+	sizeOf := func(id string) int64 {
+		size, ok := sizes[id]
+		if !ok {
+			var err error
+			if size, err = layerSize(store, id); err != nil {
+				logrus.Warnf("Failed to get size of layer %s: %v", id, err)
+				size = 0
+			}
+			sizes[id] = size
+		}
+		return size
+	}
+
+	resp := &diskUsageResponse{}
+	for i := range images {
+		image := &images[i]
+		size, err := store.ImageSize(image.ID)
+		if err != nil {
+			logrus.Warnf("Failed to get size of image %s: %v", image.ID, err)
+			continue
+		}
+		du := imageDiskUsage{ID: image.ID, RepoTags: image.Names, Size: size, Containers: imageContainers[image.ID]}
+		for _, id := range layersOfImages[image.ID] {
+			if usage.refs[id] > 1 {
+				du.SharedSize += sizeOf(id)
+			}
+		}
+		du.UniqueSize = size - du.SharedSize
+		resp.Images = append(resp.Images, du)
+		// Big data of the image is counted here, layers are counted below
+		resp.ImagesSize += du.UniqueSize
+		if du.Containers == 0 {
+			resp.ReclaimableSize += du.UniqueSize
+		}
+	}
+	for id, refs := range usage.refs {
+		if refs <= 1 {
+			continue
+		}
+		resp.ImagesSize += sizeOf(id)
+		if !usage.inUse[id] {
+			resp.ReclaimableSize += sizeOf(id)
+		}
+	}
+
+	for _, container := range containers {
+		size, err := store.ContainerSize(container.ID)
+		if err != nil {
+			logrus.Warnf("Failed to get size of container %s: %v", container.ID, err)
+			continue
+		}
+		resp.Containers = append(resp.Containers, containerDiskUsage{
+			ID:      container.ID,
+			Names:   container.Names,
+			ImageID: container.ImageID,
+			LayerID: container.LayerID,
+			Size:    size,
+		})
+		resp.ContainersSize += size
+	}
+
+	for _, id := range usage.dangling {
+		size := sizeOf(id)
+		resp.DanglingLayers = append(resp.DanglingLayers, layerDiskUsage{ID: id, Size: size})
+		resp.DanglingLayersSize += size
+	}
+	resp.ReclaimableSize += resp.DanglingLayersSize
+
+	return resp, nil
+}
+
+func diskUsage(gopts *globalOptions) (*diskUsageResponse, error) {
+	store, err := getStorageStore(gopts)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDiskUsage(store)
+}
+
+func dfHandler(c *cli.Context) error {
+	gopts, err := getGlobalOptions(c)
+	if err != nil {
+		return err
+	}
+
+	var resp *diskUsageResponse
+	sockAddr, err := isDaemonInstanceExist(defaultInfoFile)
+	if strings.Contains(err.Error(), daemonInstanceExist) {
+		resp, err = grpcCliDiskUsage(sockAddr)
+	} else if os.IsNotExist(err) {
+		resp, err = diskUsage(gopts)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var dfCmd = cli.Command{
+	Name:  "df",
+	Usage: "iSulad-img df",
+	Description: fmt.Sprintf(`
+
+	Show disk usage of images, containers and dangling layers.
+
+	`),
+	ArgsUsage: "",
+	Action:    dfHandler,
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"testing"
+
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/idtools"
+)
+
+func TestClassifyLayers(t *testing.T) {
+	layers := []string{"base", "a1", "b1", "c-rw", "orphan"}
+	imageLayers := map[string][]string{
+		"a": {"base", "a1"},
+		"b": {"base", "b1"},
+	}
+	imageContainers := map[string]int{"a": 1}
+
+	usage := classifyLayers(layers, imageLayers, imageContainers, []string{"c-rw"})
+	for id, refs := range map[string]int{"base": 2, "a1": 1, "b1": 1, "c-rw": 0, "orphan": 0} {
+		if usage.refs[id] != refs {
+			t.Errorf("layer %s has %d refs, expected %d", id, usage.refs[id], refs)
+		}
+	}
+	for id, inUse := range map[string]bool{"base": true, "a1": true, "b1": false} {
+		if usage.inUse[id] != inUse {
+			t.Errorf("layer %s in use is %v, expected %v", id, usage.inUse[id], inUse)
+		}
+	}
+	if len(usage.dangling) != 1 || usage.dangling[0] != "orphan" {
+		t.Errorf("dangling layers are %v, expected [orphan]", usage.dangling)
+	}
+}
+
+func TestDiskUsageMappedLayers(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base", "top")
+	// Layers of the image are copied with IDs shifted for the container
+	mappings := []idtools.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	container, err := svc.store.CreateContainer("", nil, image.ID, "", "{}", &storage.ContainerOptions{
+		IDMappingOptions: storage.IDMappingOptions{UIDMap: mappings, GIDMap: mappings},
+	})
+	if err != nil {
+		t.Fatalf("failed to create container: %v", err)
+	}
+	if err := svc.store.DeleteContainer(container.ID); err != nil {
+		t.Fatalf("failed to delete container: %v", err)
+	}
+	img, err := svc.store.Image(image.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(img.MappedTopLayers) == 0 {
+		t.Fatalf("image should have mapped top layers")
+	}
+
+	layers, err := imageLayerSet(svc.store, img)
+	// The mapped top layer is a flattened copy of the image
+	if err != nil || len(layers) != 3 || layers[2] != img.MappedTopLayers[0] {
+		t.Errorf("got layers %v, %v of image, want 2 layers and the mapped top layer", layers, err)
+	}
+
+	resp, err := getDiskUsage(svc.store)
+	if err != nil {
+		t.Fatalf("failed to get disk usage: %v", err)
+	}
+	if len(resp.DanglingLayers) != 0 {
+		t.Errorf("got dangling layers %v, mapped layers of the image should not be dangling", resp.DanglingLayers)
+	}
+	if len(resp.Images) != 1 || resp.Images[0].SharedSize != 0 {
+		t.Errorf("got images %+v, want one image without shared layers", resp.Images)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containers/image/types"
+	"github.com/containers/storage"
+	units "github.com/docker/go-units"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultEvictionInterval = time.Minute
+	// low watermark is this ratio of the high watermark if not set
+	defaultLowWatermarkRatio = 0.9
+)
+
+// watermark is a disk usage threshold, either in bytes like "50GB" or a
+// percentage of the filesystem size like "85%"
+type watermark struct {
+	bytes   uint64
+	percent float64
+}
+
+func parseWatermark(s string) (watermark, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "%") {
+		p, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil || p <= 0 || p > 100 {
+			return watermark{}, fmt.Errorf("invalid watermark %q", s)
+		}
+		return watermark{percent: p}, nil
+	}
+	b, err := units.FromHumanSize(s)
+	if err != nil || b <= 0 {
+		return watermark{}, fmt.Errorf("invalid watermark %q", s)
+	}
+	return watermark{bytes: uint64(b)}, nil
+}
+
+func (w *watermark) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := parseWatermark(s)
+	if err != nil {
+		return err
+	}
+	*w = v
+	return nil
+}
+
+func (w watermark) MarshalJSON() ([]byte, error) {
+	if w.percent != 0 {
+		return json.Marshal(strconv.FormatFloat(w.percent, 'f', -1, 64) + "%")
+	}
+	return json.Marshal(strconv.FormatUint(w.bytes, 10))
+}
+
+func (w watermark) isSet() bool {
+	return w.bytes != 0 || w.percent != 0
+}
+
+// value returns the watermark in bytes of a filesystem of size bytes
+func (w watermark) value(size uint64) uint64 {
+	if w.percent != 0 {
+		return uint64(float64(size) * w.percent / 100)
+	}
+	return w.bytes
+}
+
+// filesystemUsage returns the used and total bytes of the filesystem of path.
+// Unlike walking the directory it does not count mounted layers twice.
+func filesystemUsage(path string) (uint64, uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+	return (st.Blocks - st.Bfree) * uint64(st.Bsize), st.Blocks * uint64(st.Bsize), nil
+}
+
+// evictionWatermarks returns the high and low watermarks in bytes for a
+// filesystem of size bytes, high is 0 if eviction is disabled.
+func evictionWatermarks(config *daemonConfig, size uint64) (uint64, uint64) {
+	if !config.EvictionHighWatermark.isSet() {
+		return 0, 0
+	}
+
+	high := config.EvictionHighWatermark.value(size)
+	low := uint64(float64(high) * defaultLowWatermarkRatio)
+	if config.EvictionLowWatermark.isSet() {
+		low = config.EvictionLowWatermark.value(size)
+	}
+	if low > high {
+		low = high
+	}
+	return high, low
+}
+
+// evictionCandidates returns images can be evicted in least recently used
+// order, images last used at the same time are ordered by sizes, larger first
+func evictionCandidates(images []storage.Image, containers []storage.Container, sizes map[string]int64) []storage.Image {
+	inUse := make(map[string]bool)
+	for _, c := range containers {
+		inUse[c.ImageID] = true
+	}
+
+	var candidates []storage.Image
+	for _, image := range images {
+		if inUse[image.ID] || isPinned(&image) {
+			continue
+		}
+		candidates = append(candidates, image)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ti, tj := imageLastUsed(&candidates[i]), imageLastUsed(&candidates[j])
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return sizes[candidates[i].ID] > sizes[candidates[j].ID]
+	})
+	return candidates
+}
+
+// imageUniqueSize returns the size freed by removing the image, layers is the
+// layers of the image and refs the number of images referencing each layer
+func imageUniqueSize(store storage.Store, imageID string, layers []string, refs map[string]int) (int64, error) {
+	size, err := store.ImageSize(imageID)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range layers {
+		if refs[id] <= 1 {
+			continue
+		}
+		shared, err := layerSize(store, id)
+		if err != nil {
+			return 0, err
+		}
+		size -= shared
+	}
+	return size, nil
+}
+
+// evictImages removes unused images in least recently used order if usage
+// of the filesystem of graph root is above the high watermark, until it is
+// below the low watermark.
+func evictImages(imageService ImageServer) error {
+	store := imageService.GetStore()
+	root := store.GraphRoot()
+
+	used, size, err := filesystemUsage(root)
+	if err != nil {
+		return err
+	}
+	high, low := evictionWatermarks(getDaemonConfig(), size)
+	if high == 0 || used < high {
+		return nil
+	}
+
+	logrus.Warnf("Graph root %s uses %s, above high watermark %s, evicting images down to %s", root,
+		units.BytesSize(float64(used)), units.BytesSize(float64(high)), units.BytesSize(float64(low)))
+
+	images, err := store.Images()
+	if err != nil {
+		return err
+	}
+	containers, err := store.Containers()
+	if err != nil {
+		return err
+	}
+	// Sizes of images only rank the candidates, usage is taken from the
+	// filesystem again after each image is removed
+	layersOfImages, _ := imageLayerMap(store, images)
+	refs := classifyLayers(nil, layersOfImages, nil, nil).refs
+	sizes := make(map[string]int64, len(images))
+	for _, image := range images {
+		unique, err := imageUniqueSize(store, image.ID, layersOfImages[image.ID], refs)
+		if err != nil {
+			logrus.Warnf("Failed to get size of image %s: %v", image.ID, err)
+			continue
+		}
+		sizes[image.ID] = unique
+	}
+	for _, image := range evictionCandidates(images, containers, sizes) {
+		if err := imageService.UnrefImage(&types.SystemContext{}, image.ID, false); err != nil {
+			// It may be used by a container created or pinned since the candidates were chosen
+			logrus.Warnf("Failed to evict image %s: %v", image.ID, err)
+			continue
+		}
+		logrus.WithFields(logrus.Fields{
+			"image":     image.ID,
+			"names":     strings.Join(image.Names, ","),
+			"last-used": imageLastUsed(&image).Format(time.RFC3339),
+			"size":      units.BytesSize(float64(sizes[image.ID])),
+		}).Warnf("Image evicted due to disk pressure")
+
+		if used, _, err = filesystemUsage(root); err != nil {
+			return err
+		}
+		if used < low {
+			return nil
+		}
+	}
+	logrus.Warnf("Graph root %s still uses %s after eviction, no more images can be evicted",
+		root, units.BytesSize(float64(used)))
+
+	return nil
+}
+
+// startEvictionController checks disk usage periodically and evicts images
+// until the daemon shuts down. Watermarks are taken from the current daemon
+// config, eviction is disabled if the high watermark is not set.
+func startEvictionController(imageService ImageServer) {
+	goBackground("eviction controller", func() {
+		for {
+			interval := time.Duration(getDaemonConfig().EvictionInterval)
+			if interval == 0 {
+				interval = defaultEvictionInterval
+			}
+			select {
+			case <-backgroundCtx.Done():
+				return
+			case <-time.After(interval):
+			}
+			if err := evictImages(imageService); err != nil {
+				logrus.Errorf("Failed to evict images: %v", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/containers/storage"
+)
+
+func TestParseWatermark(t *testing.T) {
+	valid := map[string]watermark{
+		"85%":   {percent: 85},
+		"12.5%": {percent: 12.5},
+		"1024":  {bytes: 1024},
+		"1KB":   {bytes: 1000},
+		"2GB":   {bytes: 2000000000},
+	}
+	for s, expected := range valid {
+		w, err := parseWatermark(s)
+		if err != nil || w != expected {
+			t.Errorf("parseWatermark(%q) = %+v, %v, expected %+v", s, w, err, expected)
+		}
+	}
+	for _, s := range []string{"", "0", "0%", "101%", "-1GB", "abc", "%"} {
+		if _, err := parseWatermark(s); err == nil {
+			t.Errorf("parseWatermark(%q) should fail", s)
+		}
+	}
+}
+
+func TestEvictionConfig(t *testing.T) {
+	for data, ok := range map[string]bool{
+		`{}`: true,
+		`{"eviction-high-watermark": "80%", "eviction-low-watermark": "70%"}`:  true,
+		`{"eviction-high-watermark": "10GB", "eviction-low-watermark": "50%"}`: true,
+		`{"eviction-high-watermark": "70%", "eviction-low-watermark": "80%"}`:  false,
+		`{"eviction-high-watermark": "1GB", "eviction-low-watermark": "2GB"}`:  false,
+		`{"eviction-low-watermark": "70%"}`:                                    false,
+	} {
+		config := &daemonConfig{}
+		if err := json.Unmarshal([]byte(data), config); err != nil {
+			t.Fatalf("failed to parse %s: %v", data, err)
+		}
+		if err := config.validate(); (err == nil) != ok {
+			t.Errorf("validate %s returned %v", data, err)
+		}
+	}
+
+	for _, c := range []struct {
+		config    daemonConfig
+		size      uint64
+		high, low uint64
+	}{
+		{daemonConfig{}, 10000, 0, 0},
+		{daemonConfig{EvictionHighWatermark: watermark{bytes: 1000}}, 10000, 1000, 900},
+		{daemonConfig{EvictionHighWatermark: watermark{percent: 80}}, 10000, 8000, 7200},
+		{daemonConfig{EvictionHighWatermark: watermark{percent: 80}, EvictionLowWatermark: watermark{bytes: 5000}}, 10000, 8000, 5000},
+		{daemonConfig{EvictionHighWatermark: watermark{bytes: 1000}, EvictionLowWatermark: watermark{percent: 50}}, 10000, 1000, 1000},
+	} {
+		high, low := evictionWatermarks(&c.config, c.size)
+		if high != c.high || low != c.low {
+			t.Errorf("evictionWatermarks(%+v, %d) = %d, %d, expected %d, %d", c.config, c.size, high, low, c.high, c.low)
+		}
+	}
+
+	used, size, err := filesystemUsage("/")
+	if err != nil || size == 0 || used > size {
+		t.Errorf("filesystemUsage = %d, %d, %v", used, size, err)
+	}
+}
+
+func TestEvictionCandidates(t *testing.T) {
+	base := time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+	images := []storage.Image{
+		{ID: "a", Loaded: base},
+		{ID: "b", Loaded: base.Add(time.Hour)},
+		{ID: "c", Loaded: base.Add(2 * time.Hour)},
+		// used at the same time as c but larger
+		{ID: "e", Loaded: base.Add(2 * time.Hour)},
+		{ID: "pinned", Loaded: base, Flags: map[string]interface{}{pinnedFlag: true}},
+		{ID: "used", Loaded: base},
+		// loaded first but used last
+		{ID: "d", Loaded: base, Flags: map[string]interface{}{
+			lastUsedFlag: base.Add(3 * time.Hour).Format(time.RFC3339Nano),
+		}},
+	}
+	containers := []storage.Container{{ID: "container", ImageID: "used"}}
+
+	var ids []string
+	sizes := map[string]int64{"c": 10, "e": 20}
+	for _, image := range evictionCandidates(images, containers, sizes) {
+		ids = append(ids, image.ID)
+	}
+	expected := []string{"a", "b", "e", "c", "d"}
+	if len(ids) != len(expected) {
+		t.Fatalf("candidates are %v, expected %v", ids, expected)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Fatalf("candidates are %v, expected %v", ids, expected)
+		}
+	}
+}
+
+func TestImageUniqueSize(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	a := createTestImage(t, svc.store, "docker.io/library/a:latest", "base", "a")
+	// b is built on the base layer of a
+	baseLayer, err := svc.store.Layer(a.TopLayer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	top, _, err := svc.store.PutLayer("", baseLayer.Parent, nil, "", false, nil, testLayerTar(t, "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := svc.store.CreateImage("", []string{"docker.io/library/b:latest"}, top.ID, "", &storage.ImageOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	images, err := svc.store.Images()
+	if err != nil {
+		t.Fatal(err)
+	}
+	layersOfImages, broken := imageLayerMap(svc.store, images)
+	if len(broken) != 0 {
+		t.Fatalf("got broken images %v", broken)
+	}
+	refs := classifyLayers(nil, layersOfImages, nil, nil).refs
+	baseSize, err := layerSize(svc.store, baseLayer.Parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sizeA, err := svc.store.ImageSize(a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unique, err := imageUniqueSize(svc.store, a.ID, layersOfImages[a.ID], refs)
+	if err != nil || unique != sizeA-baseSize {
+		t.Errorf("got unique size %d, %v of a, want %d without the shared base layer", unique, err, sizeA-baseSize)
+	}
+
+	// The base layer is freed with b once a is evicted
+	for _, id := range layersOfImages[a.ID] {
+		refs[id]--
+	}
+	sizeB, err := svc.store.ImageSize(b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unique, err = imageUniqueSize(svc.store, b.ID, layersOfImages[b.ID], refs)
+	if err != nil || unique != sizeB {
+		t.Errorf("got unique size %d, %v of b, want %d", unique, err, sizeB)
+	}
+}
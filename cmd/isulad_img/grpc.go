@@ -27,7 +27,10 @@ import (
 
 	pb "isula-image/isula"
 
+	"github.com/containers/image/pkg/docker/config"
 	"github.com/containers/image/types"
+	graphdriver "github.com/containers/storage/drivers"
+	"github.com/containers/storage/pkg/idtools"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
@@ -38,12 +41,16 @@ const (
 )
 
 type daemonOptions struct {
-	gopts   *globalOptions
-	Address string
+	gopts              *globalOptions
+	Address            string
+	ConfigFile         string
+	ShutdownTimeout    time.Duration
+	MaxConcurrentPulls int
 }
 
 type grpcImageService struct {
 	daemonOptions
+	pulls *pullManager
 }
 
 func grpcCliInfo(sockAddr string, image string) (string, error) {
@@ -64,7 +71,7 @@ func grpcCliInfo(sockAddr string, image string) (string, error) {
 	return resp.Spec, nil
 }
 
-func grpcCliImages(sockAddr string, filter string, check bool) (*listImagesResponse, error) {
+func grpcCliImages(sockAddr string, filter string, check bool, lopts *listImagesOptions) (*listImagesResponse, error) {
 	conn, err := grpc.Dial(sockAddr, grpc.WithInsecure())
 	if err != nil {
 		return nil, err
@@ -76,13 +83,17 @@ func grpcCliImages(sockAddr string, filter string, check bool) (*listImagesRespo
 		Filter: &pb.ImageFilter{
 			Image: &pb.ImageSpec{Image: filter},
 		},
-		Check: check,
+		Check:      check,
+		SortBy:     lopts.sortBy,
+		Descending: lopts.descending,
+		Offset:     uint32(lopts.offset),
+		Limit:      uint32(lopts.limit),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	respImages := &listImagesResponse{}
+	respImages := &listImagesResponse{Total: int(pbImages.Total)}
 	for _, pbImage := range pbImages.Images {
 		image, err := transPBImageToImage(pbImage)
 		if err != nil {
@@ -94,6 +105,95 @@ func grpcCliImages(sockAddr string, filter string, check bool) (*listImagesRespo
 	return respImages, nil
 }
 
+func grpcCliDiskUsage(sockAddr string) (*diskUsageResponse, error) {
+	conn, err := grpc.Dial(sockAddr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	c := pb.NewImageServiceClient(conn)
+	resp, err := c.DiskUsage(context.Background(), &pb.DiskUsageRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	du := &diskUsageResponse{
+		ImagesSize:         resp.ImagesSize,
+		ContainersSize:     resp.ContainersSize,
+		DanglingLayersSize: resp.DanglingLayersSize,
+		ReclaimableSize:    resp.ReclaimableSize,
+	}
+	for _, image := range resp.Images {
+		du.Images = append(du.Images, imageDiskUsage{
+			ID:         image.Id,
+			RepoTags:   image.RepoTags,
+			Size:       image.Size,
+			SharedSize: image.SharedSize,
+			UniqueSize: image.UniqueSize,
+			Containers: int(image.Containers),
+		})
+	}
+	for _, container := range resp.Containers {
+		du.Containers = append(du.Containers, containerDiskUsage{
+			ID:      container.Id,
+			Names:   container.Names,
+			ImageID: container.ImageId,
+			LayerID: container.LayerId,
+			Size:    container.Size,
+		})
+	}
+	for _, layer := range resp.DanglingLayers {
+		du.DanglingLayers = append(du.DanglingLayers, layerDiskUsage{ID: layer.Id, Size: layer.Size})
+	}
+
+	return du, nil
+}
+
+func grpcCliVerifyImages(sockAddr string, image string, vopts *verifyOptions) ([]imageVerifyResult, error) {
+	conn, err := grpc.Dial(sockAddr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	c := pb.NewImageServiceClient(conn)
+	resp, err := c.VerifyImages(context.Background(), &pb.VerifyImagesRequest{
+		Image:      &pb.ImageSpec{Image: image},
+		Quarantine: vopts.quarantine,
+		Repair:     vopts.repair,
+		Archive:    vopts.archive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []imageVerifyResult
+	for _, img := range resp.Images {
+		result := imageVerifyResult{
+			ID:          img.Id,
+			RepoTags:    img.RepoTags,
+			OK:          img.Ok,
+			Quarantined: img.Quarantined,
+			Error:       img.Error,
+			RepairError: img.RepairError,
+		}
+		for _, layer := range img.Layers {
+			result.Layers = append(result.Layers, layerVerifyResult{
+				ID:             layer.Id,
+				DiffID:         layer.DiffId,
+				ComputedDiffID: layer.ComputedDiffId,
+				OK:             layer.Ok,
+				Error:          layer.Error,
+				Repaired:       layer.Repaired,
+			})
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 func startGrpcService(opts daemonOptions) error {
 	var l net.Listener
 	var path string
@@ -120,20 +220,29 @@ func startGrpcService(opts daemonOptions) error {
 	}
 
 	server := grpc.NewServer()
-	pb.RegisterImageServiceServer(server, &grpcImageService{
+	svc := &grpcImageService{
 		daemonOptions: opts,
-	})
+		pulls:         newPullManager(opts.MaxConcurrentPulls, gTracker),
+	}
+	pb.RegisterImageServiceServer(server, svc)
 
 	// Handle signals
+	shutdownDone := make(chan struct{})
 	c := make(chan os.Signal, signalChanSize)
-	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT, syscall.SIGPIPE)
+	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT, syscall.SIGPIPE, syscall.SIGHUP)
 	go func() {
 		for s := range c {
 			switch s {
 			case syscall.SIGTERM, syscall.SIGINT:
 				logrus.Infof("Received signal %v", s)
-				server.Stop()
-				delInfoFile(defaultInfoFile)
+				signal.Stop(c)
+				svc.gracefulShutdown(server)
+				close(shutdownDone)
+				return
+			case syscall.SIGHUP:
+				if err := reloadDaemonConfig(opts.ConfigFile); err != nil {
+					logrus.Errorf("Reload daemon config failed, keep current config: %v", err)
+				}
 			case syscall.SIGPIPE:
 				// Ignore pipe broken signal
 			}
@@ -142,7 +251,14 @@ func startGrpcService(opts daemonOptions) error {
 
 	logrus.Infof("iSulad_kit GRPC listen on %s", path)
 
-	return server.Serve(l)
+	if err := server.Serve(l); err != nil {
+		return err
+	}
+
+	// Serve returns nil only if server stopped by shutdown
+	<-shutdownDone
+
+	return nil
 }
 
 func transPBImageToImage(pbImage *pb.Image) (*Image, error) {
@@ -183,6 +299,14 @@ func transPBImageToImage(pbImage *pb.Image) (*Image, error) {
 		Username:    pbImage.Username,
 		Created:     &created,
 		Loaded:      &loaded,
+		Pinned:      pbImage.Pinned,
+	}
+	if pbImage.LastUsed != "" {
+		lastUsed, err := time.Parse(time.RFC3339Nano, pbImage.LastUsed)
+		if err != nil {
+			return nil, err
+		}
+		respImg.LastUsed = &lastUsed
 	}
 	if pbImage.Healthcheck != nil {
 		respImg.Healthcheck = &HealthConfig{
@@ -237,6 +361,10 @@ func transImageToPBImage(img *Image) (*pb.Image, error) {
 		Username:    img.Username,
 		Created:     created,
 		Loaded:      loaded,
+		Pinned:      img.Pinned,
+	}
+	if img.LastUsed != nil {
+		respImg.LastUsed = img.LastUsed.Format(time.RFC3339Nano)
 	}
 	if img.Healthcheck != nil {
 		respImg.Healthcheck = &pb.HealthCheck{
@@ -271,7 +399,12 @@ func (s *grpcImageService) ListImages(ctx context.Context, req *pb.ListImagesReq
 		filter = req.Filter.Image.Image
 	}
 
-	images, err := listImages(s.gopts, filter, req.Check)
+	images, err := listImages(s.gopts, filter, req.Check, &listImagesOptions{
+		sortBy:     req.SortBy,
+		descending: req.Descending,
+		offset:     int(req.Offset),
+		limit:      int(req.Limit),
+	})
 	if err != nil {
 		return &pb.ListImagesResponse{
 			Errmsg: err.Error(),
@@ -279,7 +412,7 @@ func (s *grpcImageService) ListImages(ctx context.Context, req *pb.ListImagesReq
 		}, err
 	}
 
-	resp := &pb.ListImagesResponse{}
+	resp := &pb.ListImagesResponse{Total: uint32(images.Total)}
 	for _, img := range images.Images {
 		respImg, err2 := transImageToPBImage(img)
 		if err2 != nil {
@@ -295,6 +428,51 @@ func (s *grpcImageService) ListImages(ctx context.Context, req *pb.ListImagesReq
 	return resp, err
 }
 
+// VerifyImages verifies layers of images against diffIDs in image config.
+func (s *grpcImageService) VerifyImages(ctx context.Context, req *pb.VerifyImagesRequest) (*pb.VerifyImagesResponse, error) {
+	image := ""
+	if req.Image != nil {
+		image = req.Image.Image
+	}
+
+	results, err := verifyImages(s.gopts, image, &verifyOptions{
+		quarantine: req.Quarantine,
+		repair:     req.Repair || req.Archive != "",
+		archive:    req.Archive,
+	})
+	if err != nil {
+		return &pb.VerifyImagesResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	resp := &pb.VerifyImagesResponse{}
+	for _, result := range results {
+		img := &pb.ImageVerifyResult{
+			Id:          result.ID,
+			RepoTags:    result.RepoTags,
+			Ok:          result.OK,
+			Quarantined: result.Quarantined,
+			Error:       result.Error,
+			RepairError: result.RepairError,
+		}
+		for _, layer := range result.Layers {
+			img.Layers = append(img.Layers, &pb.LayerVerifyResult{
+				Id:             layer.ID,
+				DiffId:         layer.DiffID,
+				ComputedDiffId: layer.ComputedDiffID,
+				Ok:             layer.OK,
+				Error:          layer.Error,
+				Repaired:       layer.Repaired,
+			})
+		}
+		resp.Images = append(resp.Images, img)
+	}
+
+	return resp, nil
+}
+
 // ImageStatus returns the status of the image. If the image is not
 // present, returns a response with ImageStatusResponse.Image set to
 // nil.
@@ -364,6 +542,8 @@ func (s *grpcImageService) PullImage(ctx context.Context, req *pb.PullImageReque
 			popts.username = req.Auth.Username
 			popts.password = req.Auth.Password
 		}
+		popts.identityToken = req.Auth.IdentityToken
+		popts.registryToken = req.Auth.RegistryToken
 
 		if req.Auth.Auth != "" {
 			var err error
@@ -379,10 +559,32 @@ func (s *grpcImageService) PullImage(ctx context.Context, req *pb.PullImageReque
 	}
 
 	popts.tlsVerify = s.gopts.TLSVerify
+	popts.maxBytesPerSec = req.MaxBytesPerSec
+	popts.maxParallelDownloads = uint(req.MaxParallelDownloads)
 
-	imageRef, err := imagePull(s.gopts, popts, req.Image.Image)
+	imageService, err := getImageService(s.gopts)
+	if err != nil {
+		return &pb.PullImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
 
-	return &pb.PullImageResponse{ImageRef: imageRef}, err
+	key := pullKey(imageService.ParseImageNames, req.Image.Image, popts)
+	result, err := s.pulls.pull(ctx, key, func() (*pullResult, error) {
+		return imagePull(s.gopts, popts, req.Image.Image)
+	})
+	if err != nil {
+		return &pb.PullImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	return &pb.PullImageResponse{
+		ImageRef: result.imageRef,
+		Attempts: uint32(result.attempts),
+	}, nil
 }
 
 // RemoveImage removes the image.
@@ -397,7 +599,7 @@ func (s *grpcImageService) RemoveImage(ctx context.Context, req *pb.RemoveImageR
 		}, err
 	}
 
-	err := imageRemove(s.gopts, req.Image.Image)
+	err := imageRemove(s.gopts, req.Image.Image, req.Force)
 	if err != nil {
 		return &pb.RemoveImageResponse{
 			Errmsg: err.Error(),
@@ -408,8 +610,56 @@ func (s *grpcImageService) RemoveImage(ctx context.Context, req *pb.RemoveImageR
 	return &pb.RemoveImageResponse{}, err
 }
 
+// PinImage protects the image from removal without force and from eviction
+func (s *grpcImageService) PinImage(ctx context.Context, req *pb.PinImageRequest) (*pb.PinImageResponse, error) {
+	if req == nil || req.Image == nil || req.Image.Image == "" {
+		err := errors.New("Lack infomation for pin image")
+		return &pb.PinImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	if err := imagePin(s.gopts, req.Image.Image, true); err != nil {
+		return &pb.PinImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	return &pb.PinImageResponse{}, nil
+}
+
+// UnpinImage unpins the image
+func (s *grpcImageService) UnpinImage(ctx context.Context, req *pb.UnpinImageRequest) (*pb.UnpinImageResponse, error) {
+	if req == nil || req.Image == nil || req.Image.Image == "" {
+		err := errors.New("Lack infomation for unpin image")
+		return &pb.UnpinImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	if err := imagePin(s.gopts, req.Image.Image, false); err != nil {
+		return &pb.UnpinImageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	return &pb.UnpinImageResponse{}, nil
+}
+
 // Load image from file
 func (s *grpcImageService) LoadImage(ctx context.Context, req *pb.LoadImageRequest) (*pb.LoadImageResponose, error) {
+	if err := gTracker.enter(); err != nil {
+		return &pb.LoadImageResponose{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+	defer gTracker.leave()
+
 	outmsg, err := loadImage(s.gopts, &loadOptions{
 		input: req.File,
 		tag:   req.Tag,
@@ -427,6 +677,14 @@ func (s *grpcImageService) LoadImage(ctx context.Context, req *pb.LoadImageReque
 
 // Import rootfs to be image
 func (s *grpcImageService) Import(ctx context.Context, req *pb.ImportRequest) (*pb.ImportResponose, error) {
+	if err := gTracker.enter(); err != nil {
+		return &pb.ImportResponose{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+	defer gTracker.leave()
+
 	id, err := importImage(s.gopts, req.File, req.Tag)
 	if err != nil {
 		return &pb.ImportResponose{
@@ -476,6 +734,48 @@ func (s *grpcImageService) ImageFsInfo(context.Context, *pb.ImageFsInfoRequest)
 	return &pb.ImageFsInfoResponse{ImageFilesystems: copyImageFsUsage(fsUsage)}, nil
 }
 
+// DiskUsage returns disk usage of images, containers and dangling layers
+func (s *grpcImageService) DiskUsage(context.Context, *pb.DiskUsageRequest) (*pb.DiskUsageResponse, error) {
+	du, err := diskUsage(s.gopts)
+	if err != nil {
+		return &pb.DiskUsageResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	resp := &pb.DiskUsageResponse{
+		ImagesSize:         du.ImagesSize,
+		ContainersSize:     du.ContainersSize,
+		DanglingLayersSize: du.DanglingLayersSize,
+		ReclaimableSize:    du.ReclaimableSize,
+	}
+	for _, image := range du.Images {
+		resp.Images = append(resp.Images, &pb.ImageDiskUsage{
+			Id:         image.ID,
+			RepoTags:   image.RepoTags,
+			Size:       image.Size,
+			SharedSize: image.SharedSize,
+			UniqueSize: image.UniqueSize,
+			Containers: uint32(image.Containers),
+		})
+	}
+	for _, container := range du.Containers {
+		resp.Containers = append(resp.Containers, &pb.ContainerDiskUsage{
+			Id:      container.ID,
+			Names:   container.Names,
+			ImageId: container.ImageID,
+			LayerId: container.LayerID,
+			Size:    container.Size,
+		})
+	}
+	for _, layer := range du.DanglingLayers {
+		resp.DanglingLayers = append(resp.DanglingLayers, &pb.LayerDiskUsage{Id: layer.ID, Size: layer.Size})
+	}
+
+	return resp, nil
+}
+
 func storageOptsArrayToMap(storageOpts []string) (map[string]string, error) {
 	if storageOpts == nil {
 		return nil, nil
@@ -514,7 +814,39 @@ func (s *grpcImageService) ContainerPrepare(ctx context.Context, req *pb.Contain
 		}, err
 	}
 
-	mountPoint, imageConfig, err := containerPrepare(s.gopts, sopts, req.Image, req.Id, req.Name)
+	if req.AutoUserns && (len(req.UidMaps) != 0 || len(req.GidMaps) != 0) {
+		err := errors.New("ID mappings can not be set with auto userns")
+		return &pb.ContainerPrepareResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+	if req.Pod != nil && req.Pod.Id == "" {
+		err := errors.New("Lack infomation for pod of container")
+		return &pb.ContainerPrepareResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+	options := &ContainerCreateOptions{
+		UIDMap:         transPBIDMapsToIDMaps(req.UidMaps),
+		GIDMap:         transPBIDMapsToIDMaps(req.GidMaps),
+		AutoUserns:     req.AutoUserns,
+		AutoUsernsSize: req.AutoUsernsSize,
+		MountLabel:     req.MountLabel,
+		LabelOpts:      req.LabelOpts,
+	}
+	if req.Pod != nil {
+		options.Pod = &PodInfo{
+			ID:        req.Pod.Id,
+			Name:      req.Pod.Name,
+			Namespace: req.Pod.Namespace,
+			UID:       req.Pod.Uid,
+			Attempt:   req.Pod.Attempt,
+		}
+	}
+
+	spec, err := containerPrepare(s.gopts, sopts, req.Image, req.Id, req.Name, options)
 	if err != nil {
 		return &pb.ContainerPrepareResponse{
 			Errmsg: err.Error(),
@@ -522,7 +854,7 @@ func (s *grpcImageService) ContainerPrepare(ctx context.Context, req *pb.Contain
 		}, err
 	}
 
-	jsonConfig, err := json.Marshal(imageConfig)
+	jsonConfig, err := json.Marshal(spec.Config)
 	if err != nil {
 		return &pb.ContainerPrepareResponse{
 			Errmsg: err.Error(),
@@ -531,11 +863,40 @@ func (s *grpcImageService) ContainerPrepare(ctx context.Context, req *pb.Contain
 	}
 
 	return &pb.ContainerPrepareResponse{
-		MountPoint: mountPoint,
+		MountPoint: spec.MountPoint,
 		ImageConf:  string(jsonConfig),
+		UidMaps:    transIDMapsToPBIDMaps(spec.UIDMap),
+		GidMaps:    transIDMapsToPBIDMaps(spec.GIDMap),
+
+		MountLabel:   spec.MountLabel,
+		ProcessLabel: spec.ProcessLabel,
 	}, nil
 }
 
+func transPBIDMapsToIDMaps(pbMaps []*pb.IDMap) []idtools.IDMap {
+	var maps []idtools.IDMap
+	for _, m := range pbMaps {
+		maps = append(maps, idtools.IDMap{
+			ContainerID: int(m.ContainerId),
+			HostID:      int(m.HostId),
+			Size:        int(m.Size),
+		})
+	}
+	return maps
+}
+
+func transIDMapsToPBIDMaps(maps []idtools.IDMap) []*pb.IDMap {
+	var pbMaps []*pb.IDMap
+	for _, m := range maps {
+		pbMaps = append(pbMaps, &pb.IDMap{
+			ContainerId: uint32(m.ContainerID),
+			HostId:      uint32(m.HostID),
+			Size:        uint32(m.Size),
+		})
+	}
+	return pbMaps
+}
+
 // remove rootfs of container
 func (s *grpcImageService) ContainerRemove(ctx context.Context, req *pb.ContainerRemoveRequest) (*pb.ContainerRemoveResponse, error) {
 	if req == nil || req.NameId == "" {
@@ -557,6 +918,28 @@ func (s *grpcImageService) ContainerRemove(ctx context.Context, req *pb.Containe
 	return &pb.ContainerRemoveResponse{}, err
 }
 
+// remove rootfs of all containers of a pod
+func (s *grpcImageService) RemovePod(ctx context.Context, req *pb.RemovePodRequest) (*pb.RemovePodResponse, error) {
+	if req == nil || req.PodId == "" {
+		err := errors.New("Lack infomation for pod remove")
+		return &pb.RemovePodResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	removed, err := podRemove(s.gopts, req.PodId)
+	if err != nil {
+		return &pb.RemovePodResponse{
+			Containers: removed,
+			Errmsg:     err.Error(),
+			Cc:         1,
+		}, err
+	}
+
+	return &pb.RemovePodResponse{Containers: removed}, nil
+}
+
 // mount rwlayer for container
 func (s *grpcImageService) ContainerMount(ctx context.Context, req *pb.ContainerMountRequest) (*pb.ContainerMountResponse, error) {
 	if req == nil || req.NameId == "" {
@@ -609,6 +992,14 @@ func (s *grpcImageService) ContainerExport(ctx context.Context, req *pb.Containe
 		}, err
 	}
 
+	if err := gTracker.enter(); err != nil {
+		return &pb.ContainerExportResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+	defer gTracker.leave()
+
 	err := exportRootfs(s.gopts, &exportOptions{file: req.Output}, req.NameId)
 	if err != nil {
 		return &pb.ContainerExportResponse{
@@ -641,6 +1032,73 @@ func (s *grpcImageService) ContainerFsUsage(ctx context.Context, req *pb.Contain
 	return &pb.ContainerFsUsageResponse{Usage: string(fsUsage)}, nil
 }
 
+// change size limit of container filesystem
+func (s *grpcImageService) ContainerSetQuota(ctx context.Context, req *pb.ContainerSetQuotaRequest) (*pb.ContainerSetQuotaResponse, error) {
+	if req == nil || req.NameId == "" || req.Size == "" {
+		err := errors.New("Lack infomation for container set quota")
+		return &pb.ContainerSetQuotaResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	fsUsage, err := containerSetQuota(s.gopts, req.NameId, req.Size)
+	if err != nil {
+		return &pb.ContainerSetQuotaResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	resp := &pb.ContainerSetQuotaResponse{}
+	if fsUsage.LimitBytes != nil {
+		resp.LimitBytes = fsUsage.LimitBytes.Value
+	}
+	if fsUsage.UsedBytes != nil {
+		resp.UsedBytes = fsUsage.UsedBytes.Value
+	}
+	if fsUsage.InodesUsed != nil {
+		resp.InodesUsed = fsUsage.InodesUsed.Value
+	}
+	return resp, nil
+}
+
+func transOverlayStatusToPB(status *graphdriver.OverlayStatus) *pb.OverlayStatus {
+	if status == nil {
+		return nil
+	}
+	return &pb.OverlayStatus{
+		SupportsDType: status.SupportsDType,
+		NativeDiff:    status.NativeDiff,
+		ProjectQuota:  status.ProjectQuota,
+	}
+}
+
+func transDevmapperStatusToPB(status *graphdriver.DevmapperStatus) *pb.DevicemapperStatus {
+	if status == nil {
+		return nil
+	}
+	return &pb.DevicemapperStatus{
+		PoolName:               status.PoolName,
+		DataFile:               status.DataFile,
+		MetadataFile:           status.MetadataFile,
+		DataLoopFile:           status.DataLoopback,
+		MetadataLoopFile:       status.MetadataLoopback,
+		DataUsed:               status.DataUsed,
+		DataTotal:              status.DataTotal,
+		DataAvailable:          status.DataAvailable,
+		MetadataUsed:           status.MetadataUsed,
+		MetadataTotal:          status.MetadataTotal,
+		MetadataAvailable:      status.MetadataAvailable,
+		MinFreeSpace:           status.MinFreeSpace,
+		BaseDeviceSize:         status.BaseDeviceSize,
+		UdevSyncSupported:      status.UdevSyncSupported,
+		DeferredRemoval:        status.DeferredRemoval,
+		DeferredDeletion:       status.DeferredDeletion,
+		DeferredDeletedDevices: uint32(status.DeferredDeletedDeviceCount),
+	}
+}
+
 // get status of graphdriver
 func (s *grpcImageService) GraphdriverStatus(ctx context.Context, req *pb.GraphdriverStatusRequest) (*pb.GraphdriverStatusResponse, error) {
 	status, err := storageStatus(s.gopts)
@@ -651,16 +1109,20 @@ func (s *grpcImageService) GraphdriverStatus(ctx context.Context, req *pb.Graphd
 		}, err
 	}
 
-	var gotBackingFs bool
-	resp := &pb.GraphdriverStatusResponse{}
-	for _, kv := range status {
-		if kv[0] == "Backing Filesystem" {
-			gotBackingFs = true
-		}
+	resp := &pb.GraphdriverStatusResponse{
+		DriverName:        status.Name,
+		BackingFilesystem: status.BackingFilesystem,
+	}
+	for _, kv := range status.Pairs {
 		resp.Status += fmt.Sprintf("%s: %s\n", kv[0], kv[1])
 	}
+	if status.Typed != nil {
+		resp.Overlay = transOverlayStatusToPB(status.Typed.Overlay)
+		resp.Devicemapper = transDevmapperStatusToPB(status.Typed.Devmapper)
+		resp.Warnings = status.Typed.Warnings
+	}
 
-	if !gotBackingFs {
+	if status.BackingFilesystem == "" {
 		err := errors.New("Internal error, failed to get backing filesystem")
 		return &pb.GraphdriverStatusResponse{
 			Errmsg: err.Error(),
@@ -697,7 +1159,7 @@ func (s *grpcImageService) GraphdriverMetadata(ctx context.Context, req *pb.Grap
 
 // login registry
 func (s *grpcImageService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	if req == nil || req.Server == "" || req.Username == "" || req.Password == "" {
+	if req == nil || req.Server == "" {
 		err := errors.New("Lack infomation for login")
 		return &pb.LoginResponse{
 			Errmsg: err.Error(),
@@ -705,13 +1167,21 @@ func (s *grpcImageService) Login(ctx context.Context, req *pb.LoginRequest) (*pb
 		}, err
 	}
 
+	auth, err := loginAuthConfig(req)
+	if err != nil {
+		return &pb.LoginResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
 	sys := &types.SystemContext{
 		DockerInsecureSkipTLSVerify:       types.NewOptionalBool(!s.gopts.TLSVerify),
 		DockerDaemonInsecureSkipTLSVerify: !s.gopts.TLSVerify,
 		AuthFilePath:                      defaultAuthFilePath(),
 	}
 
-	err := loginRegistry(s.gopts, sys, req.Username, req.Password, req.Server)
+	err = loginRegistry(s.gopts, sys, auth, req.Server)
 	if err != nil {
 		return &pb.LoginResponse{
 			Errmsg: err.Error(),
@@ -749,6 +1219,60 @@ func (s *grpcImageService) Logout(ctx context.Context, req *pb.LogoutRequest) (*
 	return &pb.LogoutResponse{}, nil
 }
 
+func (s *grpcImageService) authSystemContext() *types.SystemContext {
+	return &types.SystemContext{
+		AuthFilePath: defaultAuthFilePath(),
+	}
+}
+
+// list registries logged in
+func (s *grpcImageService) ListLogins(ctx context.Context, req *pb.ListLoginsRequest) (*pb.ListLoginsResponse, error) {
+	logins, err := listLogins(s.authSystemContext())
+	if err != nil {
+		return &pb.ListLoginsResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	resp := &pb.ListLoginsResponse{}
+	for _, l := range logins {
+		resp.Logins = append(resp.Logins, &pb.LoginInfo{
+			Server:   l.server,
+			Username: l.username,
+			Store:    l.store,
+		})
+	}
+
+	return resp, nil
+}
+
+// logout all registries
+func (s *grpcImageService) LogoutAll(ctx context.Context, req *pb.LogoutAllRequest) (*pb.LogoutAllResponse, error) {
+	servers, err := logoutAll(s.authSystemContext())
+	if err != nil {
+		return &pb.LogoutAllResponse{
+			Servers: servers,
+			Errmsg:  err.Error(),
+			Cc:      1,
+		}, err
+	}
+
+	return &pb.LogoutAllResponse{Servers: servers}, nil
+}
+
+// rotate AES key of auths
+func (s *grpcImageService) RotateAuthKey(ctx context.Context, req *pb.RotateAuthKeyRequest) (*pb.RotateAuthKeyResponse, error) {
+	if err := config.RotateKey(s.authSystemContext()); err != nil {
+		return &pb.RotateAuthKeyResponse{
+			Errmsg: err.Error(),
+			Cc:     1,
+		}, err
+	}
+
+	return &pb.RotateAuthKeyResponse{}, nil
+}
+
 // health check service
 func (s *grpcImageService) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	return &pb.HealthCheckResponse{}, nil
@@ -756,7 +1280,11 @@ func (s *grpcImageService) HealthCheck(ctx context.Context, req *pb.HealthCheckR
 
 // list containers
 func (s *grpcImageService) ListContainers(ctx context.Context, req *pb.ListContainersRequest) (*pb.ListContainersResponse, error) {
-	containers, err := containerList(s.gopts)
+	var podID string
+	if req != nil {
+		podID = req.PodId
+	}
+	containers, err := containerList(s.gopts, podID)
 	if err != nil {
 		return &pb.ListContainersResponse{
 			Errmsg: err.Error(),
@@ -764,7 +1292,36 @@ func (s *grpcImageService) ListContainers(ctx context.Context, req *pb.ListConta
 		}, err
 	}
 
-	return &pb.ListContainersResponse{Containers: containers}, nil
+	resp := &pb.ListContainersResponse{Containers: make(map[string]bool)}
+	for _, c := range containers {
+		resp.Containers[c.ID] = c.Mounted
+		resp.Infos = append(resp.Infos, transContainerInfoToPB(&c))
+	}
+
+	return resp, nil
+}
+
+func transContainerInfoToPB(c *containerInfo) *pb.ContainerInfo {
+	info := &pb.ContainerInfo{
+		Id:         c.ID,
+		Names:      c.Names,
+		ImageId:    c.Metadata.ImageID,
+		ImageName:  c.Metadata.ImageName,
+		LayerId:    c.LayerID,
+		Mounted:    c.Mounted,
+		CreatedAt:  c.Metadata.CreatedAt,
+		MountLabel: c.Metadata.MountLabel,
+	}
+	if c.Metadata.Pod {
+		info.Pod = &pb.PodInfo{
+			Id:        c.Metadata.PodID,
+			Name:      c.Metadata.PodName,
+			Namespace: c.Metadata.Namespace,
+			Uid:       c.Metadata.UID,
+			Attempt:   c.Metadata.Attempt,
+		}
+	}
+	return info
 }
 
 func (s *grpcImageService) TagImage(ctx context.Context, req *pb.TagImageRequest) (*pb.TagImageResponse, error) {
@@ -102,6 +102,10 @@ type ImageBasicSpec struct {
 	Created      *time.Time `json:"created,omitempty"`
 	// Loaded is the combined date and time at which the image was pulled, formatted as defined by RFC 3339, section 5.6.
 	Loaded *time.Time `json:"Loaded,omitempty"`
+	// LastUsed is the last time a container was prepared from the image, nil if never
+	LastUsed *time.Time `json:"last_used,omitempty"`
+	// Pinned images can not be removed without force, nor be evicted
+	Pinned bool `json:"pinned,omitempty"`
 }
 
 type registryIndexInfo struct {
@@ -138,16 +142,22 @@ type sizer interface {
 type ImageServer interface {
 	// InitImage returns an Image
 	InitImage(image parsedImageNames, options *copy.Options) (types.Image, error)
-	// PullImage pull an image
-	PullImage(systemContext *types.SystemContext, image parsedImageNames, dstImage string, options *copy.Options) (types.ImageReference, error)
+	// PullImage pull an image, blobs are downloaded at most maxBytesPerSec if it is not 0
+	PullImage(systemContext *types.SystemContext, image parsedImageNames, dstImage string, options *copy.Options, maxBytesPerSec int64) (types.ImageReference, error)
 	// CheckImages
-	IntegrationCheck(systemContext *types.SystemContext) error
+	IntegrationCheck(systemContext *types.SystemContext, options integrationCheckOptions) error
+	// VerifyImages verifies layers of images against diffIDs in their config
+	VerifyImages(systemContext *types.SystemContext, imageName string, quarantine bool) ([]imageVerifyResult, error)
+	// RepairImage fetches failed layers in result again from sources
+	RepairImage(result *imageVerifyResult, sources []repairSource) error
 	// GetAllImages returns all images matches the filter
 	GetAllImages(systemContext *types.SystemContext, filter string) ([]ImageBasicSpec, error)
 	// GetOneImage returns an image matches the filter
 	GetOneImage(systemContext *types.SystemContext, filter string) (*ImageBasicSpec, error)
-	// UnrefImage reduce reference of the image
-	UnrefImage(systemContext *types.SystemContext, imageName string) error
+	// UnrefImage reduce reference of the image, pinned images are not deleted unless force is set
+	UnrefImage(systemContext *types.SystemContext, imageName string, force bool) error
+	// PinImage pins or unpins the image
+	PinImage(imageName string, pinned bool) error
 	// GetStore returns storage store
 	GetStore() storage.Store
 	// ParseImageNames parses an image
@@ -171,7 +181,7 @@ func (svc *imageService) InitImage(image parsedImageNames, options *copy.Options
 	return srcRef.NewImage(svc.ctx, srcCtx)
 }
 
-func (svc *imageService) PullImage(systemContext *types.SystemContext, image parsedImageNames, dstImage string, options *copy.Options) (types.ImageReference, error) {
+func (svc *imageService) PullImage(systemContext *types.SystemContext, image parsedImageNames, dstImage string, options *copy.Options, maxBytesPerSec int64) (types.ImageReference, error) {
 	policy, err := signature.DefaultPolicy(systemContext)
 	if err != nil {
 		return nil, err
@@ -193,14 +203,14 @@ func (svc *imageService) PullImage(systemContext *types.SystemContext, image par
 	if err != nil {
 		return nil, err
 	}
-	_, err = copy.Image(svc.ctx, policyContext, destRef, srcRef, options)
+	_, err = copy.Image(svc.ctx, policyContext, destRef, gBandwidth.wrap(srcRef, maxBytesPerSec), options)
 	if err != nil {
 		return nil, err
 	}
 	return destRef, nil
 }
 
-func (svc *imageService) IntegrationCheck(systemContext *types.SystemContext) error {
+func (svc *imageService) IntegrationCheck(systemContext *types.SystemContext, options integrationCheckOptions) error {
 	svc.store.GetCheckedLayers()
 	defer svc.store.CleanupCheckedLayers()
 
@@ -208,10 +218,32 @@ func (svc *imageService) IntegrationCheck(systemContext *types.SystemContext) er
 	if err != nil {
 		return err
 	}
-	for _, image := range images {
+
+	layersOfImages, broken := imageLayerMap(svc.store, images)
+	var allLayers []string
+	for _, layers := range layersOfImages {
+		allLayers = append(allLayers, layers...)
+	}
+	layerErrs := svc.store.CheckLayers(allLayers, options.workers(), options.Fast)
+	for id, layers := range layersOfImages {
+		for _, layer := range layers {
+			if err, ok := layerErrs[layer]; ok {
+				broken[id] = fmt.Errorf("layer %s check failed with: %s", layer, err)
+				break
+			}
+		}
+	}
+
+	for i, image := range images {
 		logrus.Debugf("Try to check image %s", image.ID)
-		err = svc.store.CheckImage(image.ID)
+		err = broken[image.ID]
 		if err != nil {
+			delete(layersOfImages, image.ID)
+			if _, ok := isQuarantined(&images[i]); ok {
+				// Left for the operator to repair or remove
+				logrus.Warnf("Keep quarantined image %s: %s", image.ID, err)
+				continue
+			}
 			logrus.Errorf("Delete image %s due to: %s", image.ID, err)
 			err = svc.store.DeleteContainersByImage(image.ID)
 			if err != nil {
@@ -246,6 +278,10 @@ func (svc *imageService) IntegrationCheck(systemContext *types.SystemContext) er
 		logrus.Errorf("Failed to delete unchecked layers: %v", err)
 	}
 
+	if options.Fast {
+		deepCheckInBackground(svc.store, layersOfImages)
+	}
+
 	return nil
 }
 
@@ -301,12 +337,14 @@ func (svc *imageService) GetOneImage(systemContext *types.SystemContext, nameOrI
 		User:         imageConfig.Config.User,
 		Created:      &image.Created,
 		Loaded:       &image.Loaded,
+		LastUsed:     imageLastPrepared(image),
+		Pinned:       isPinned(image),
 	}
 
 	return &result, nil
 }
 
-func (svc *imageService) UnrefImage(systemContext *types.SystemContext, imageName string) error {
+func (svc *imageService) UnrefImage(systemContext *types.SystemContext, imageName string, force bool) error {
 	ref, err := svc.parseImageName(imageName)
 	if err != nil {
 		return err
@@ -316,6 +354,12 @@ func (svc *imageService) UnrefImage(systemContext *types.SystemContext, imageNam
 		return err
 	}
 
+	// Removing one of the names of a pinned image is rejected too, or the
+	// image could lose all its names one by one
+	if isPinned(img) && !force {
+		return fmt.Errorf("image %s is pinned, unpin it or remove it with force", imageName)
+	}
+
 	if !strings.HasPrefix(img.ID, imageName) {
 		namedRef, err := svc.initReference(imageName, false, &copy.Options{})
 		if err != nil {
@@ -331,6 +375,22 @@ func (svc *imageService) UnrefImage(systemContext *types.SystemContext, imageNam
 	return ref.DeleteImage(svc.ctx, systemContext)
 }
 
+func (svc *imageService) PinImage(imageName string, pinned bool) error {
+	ref, err := svc.parseImageName(imageName)
+	if err != nil {
+		return err
+	}
+	img, err := imstorage.Transport.GetStoreImage(svc.store, ref)
+	if err != nil {
+		return err
+	}
+
+	if !pinned {
+		return svc.store.ClearImageFlag(img.ID, pinnedFlag)
+	}
+	return svc.store.SetImageFlag(img.ID, pinnedFlag, true)
+}
+
 func (svc *imageService) Tag(srcName, destName string) error {
 	ref, err := svc.parseImageName(srcName)
 	if err != nil {
@@ -539,6 +599,8 @@ func (svc *imageService) appendSummaryResult(systemContext *types.SystemContext,
 		User:         summaryItem.user,
 		Created:      &created,
 		Loaded:       &loaded,
+		LastUsed:     imageLastPrepared(image),
+		Pinned:       isPinned(image),
 	}), nil
 }
 
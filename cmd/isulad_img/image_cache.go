@@ -0,0 +1,200 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/containers/storage"
+)
+
+// cacheTimeSlack covers the coarse granularity of file modification times,
+// the cache is checked against changes made a little before it was built.
+const cacheTimeSlack = time.Second
+
+type imageCacheEntry struct {
+	// fingerprint of the image and layer metadata the summary was built from
+	fingerprint string
+	image       *Image
+}
+
+// imageSummaryCache keeps summaries of all images so that listing images does
+// not open every image. It is compared with the store only if the image or
+// layer stores were touched since it was last checked, and only summaries of
+// images changed are built again.
+type imageSummaryCache struct {
+	sync.Mutex
+	checked time.Time
+	// ids of images in store order
+	ids     []string
+	entries map[string]*imageCacheEntry
+}
+
+var gImageCache = &imageSummaryCache{}
+
+// fingerprintImage and fingerprintLayer drop the generated JSON marshallers of
+// images and layers, which write maps in random order
+type fingerprintImage storage.Image
+type fingerprintLayer storage.Layer
+
+// imageFingerprint returns the metadata of image and the layers it is made
+// of, empty if they can not be read. Sizes and flags of layers are part of
+// the summary and may change without the image being modified.
+func imageFingerprint(store storage.Store, image *storage.Image) string {
+	data, err := json.Marshal((*fingerprintImage)(image))
+	if err != nil {
+		return ""
+	}
+	fingerprint := string(data)
+	for id := image.TopLayer; id != ""; {
+		layer, err := store.Layer(id)
+		if err != nil {
+			return ""
+		}
+		if data, err = json.Marshal((*fingerprintLayer)(layer)); err != nil {
+			return ""
+		}
+		fingerprint += string(data)
+		id = layer.Parent
+	}
+	return fingerprint
+}
+
+// list returns summaries of all images in store, build is called for images
+// not cached or changed. If a summary can not be built, the error is returned
+// and the cache is left unchanged so that it is built again by the next call.
+// The returned summaries are shared and must not be modified.
+func (c *imageSummaryCache) list(store storage.Store, build func(id string) (*Image, error)) ([]*Image, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.entries == nil || store.ImagesTouchedSince(c.checked) || store.LayersTouchedSince(c.checked) {
+		now := time.Now()
+		images, err := store.Images()
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		entries := make(map[string]*imageCacheEntry, len(images))
+		for i := range images {
+			id := images[i].ID
+			fingerprint := imageFingerprint(store, &images[i])
+			entry, ok := c.entries[id]
+			if !ok || fingerprint == "" || entry.fingerprint != fingerprint {
+				image, err := build(id)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get summary of image %s: %v", id, err)
+				}
+				entry = &imageCacheEntry{fingerprint: fingerprint, image: image}
+			}
+			entries[id] = entry
+			ids = append(ids, id)
+		}
+		c.ids, c.entries = ids, entries
+		c.checked = now.Add(-cacheTimeSlack)
+	}
+
+	images := make([]*Image, 0, len(c.ids))
+	for _, id := range c.ids {
+		images = append(images, c.entries[id].image)
+	}
+	return images, nil
+}
+
+// listImagesOptions are sort and pagination options of listing images
+type listImagesOptions struct {
+	// sortBy is one of "id", "name", "created", "loaded" and "size", images
+	// are in store order if empty
+	sortBy     string
+	descending bool
+	// offset is the number of images skipped, limit is the maximum number of
+	// images returned, 0 means no limit
+	offset int
+	limit  int
+}
+
+func timeOf(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func imageName(image *Image) string {
+	if len(image.RepoTags) > 0 {
+		return image.RepoTags[0]
+	}
+	if len(image.RepoDigests) > 0 {
+		return image.RepoDigests[0]
+	}
+	return ""
+}
+
+func (o *listImagesOptions) validate() error {
+	switch o.sortBy {
+	case "", "id", "name", "created", "loaded", "size":
+	default:
+		return fmt.Errorf("unsupported sort key %q", o.sortBy)
+	}
+	if o.offset < 0 || o.limit < 0 {
+		return fmt.Errorf("invalid offset %d or limit %d", o.offset, o.limit)
+	}
+	return nil
+}
+
+// apply sorts and pages images, images is not modified
+func (o *listImagesOptions) apply(images []*Image) []*Image {
+	sorted := make([]*Image, len(images))
+	copy(sorted, images)
+
+	var less func(a, b *Image) bool
+	switch o.sortBy {
+	case "id":
+		less = func(a, b *Image) bool { return a.ID < b.ID }
+	case "name":
+		less = func(a, b *Image) bool { return imageName(a) < imageName(b) }
+	case "created":
+		less = func(a, b *Image) bool { return timeOf(a.Created).Before(timeOf(b.Created)) }
+	case "loaded":
+		less = func(a, b *Image) bool { return timeOf(a.Loaded).Before(timeOf(b.Loaded)) }
+	case "size":
+		less = func(a, b *Image) bool { return a.Size < b.Size }
+	}
+	if less != nil {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			if o.descending {
+				return less(sorted[j], sorted[i])
+			}
+			return less(sorted[i], sorted[j])
+		})
+	} else if o.descending {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+
+	if o.offset >= len(sorted) {
+		return nil
+	}
+	sorted = sorted[o.offset:]
+	if o.limit > 0 && o.limit < len(sorted) {
+		sorted = sorted[:o.limit]
+	}
+	return sorted
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func imageIDs(images []*Image) string {
+	var ids []string
+	for _, image := range images {
+		ids = append(ids, image.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestListImagesOptions(t *testing.T) {
+	now := time.Now()
+	t1, t2, t3 := now.Add(-time.Hour), now, now.Add(-2*time.Hour)
+	images := []*Image{
+		{ID: "b", RepoTags: []string{"busybox:latest"}, Size: 20, Created: &t1},
+		{ID: "c", RepoTags: []string{"alpine:latest"}, Size: 10, Created: &t2},
+		{ID: "a", RepoDigests: []string{"nginx@sha256:1234"}, Size: 30, Created: &t3},
+	}
+
+	cases := []struct {
+		opts   listImagesOptions
+		expect string
+	}{
+		{listImagesOptions{}, "b,c,a"},
+		{listImagesOptions{descending: true}, "a,c,b"},
+		{listImagesOptions{sortBy: "id"}, "a,b,c"},
+		{listImagesOptions{sortBy: "name"}, "c,b,a"},
+		{listImagesOptions{sortBy: "created"}, "a,b,c"},
+		{listImagesOptions{sortBy: "size", descending: true}, "a,b,c"},
+		{listImagesOptions{sortBy: "id", offset: 1}, "b,c"},
+		{listImagesOptions{sortBy: "id", offset: 1, limit: 1}, "b"},
+		{listImagesOptions{sortBy: "id", limit: 5}, "a,b,c"},
+		{listImagesOptions{offset: 3}, ""},
+	}
+
+	for _, c := range cases {
+		if err := c.opts.validate(); err != nil {
+			t.Errorf("unexpected error for %+v: %v", c.opts, err)
+			continue
+		}
+		if ids := imageIDs(c.opts.apply(images)); ids != c.expect {
+			t.Errorf("expect %q for %+v, got %q", c.expect, c.opts, ids)
+		}
+	}
+	if ids := imageIDs(images); ids != "b,c,a" {
+		t.Errorf("images modified by apply: %q", ids)
+	}
+
+	for _, opts := range []listImagesOptions{{sortBy: "tag"}, {offset: -1}, {limit: -1}} {
+		if err := opts.validate(); err == nil {
+			t.Errorf("expect error for %+v", opts)
+		}
+	}
+}
+
+func TestImageSummaryCache(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	store := svc.store
+
+	image := createTestImage(t, store, "cache:latest", "base")
+	cache := &imageSummaryCache{}
+	var built []string
+	var buildErr error
+	build := func(id string) (*Image, error) {
+		built = append(built, id)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+		return &Image{ID: id}, nil
+	}
+	expectBuilt := func(step string, expect int) {
+		t.Helper()
+		images, err := cache.list(store, build)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step, err)
+		}
+		if ids := imageIDs(images); ids != image.ID {
+			t.Errorf("%s: expect images %q, got %q", step, image.ID, ids)
+		}
+		if len(built) != expect {
+			t.Errorf("%s: expect %d summaries built, got %d", step, expect, len(built))
+		}
+		built = nil
+	}
+
+	expectBuilt("first list", 1)
+	expectBuilt("unchanged", 0)
+
+	if err := store.SetNames(image.ID, []string{"cache:latest", "cache:v1"}); err != nil {
+		t.Fatal(err)
+	}
+	expectBuilt("image changed", 1)
+
+	if err := store.SetNames(image.TopLayer, []string{"cache-layer"}); err != nil {
+		t.Fatal(err)
+	}
+	expectBuilt("layer changed", 1)
+
+	if err := store.SetNames(image.ID, []string{"cache:latest"}); err != nil {
+		t.Fatal(err)
+	}
+	buildErr = errors.New("broken image")
+	if _, err := cache.list(store, build); err == nil {
+		t.Errorf("expect error when the summary can not be built")
+	}
+	built, buildErr = nil, nil
+	expectBuilt("retry after error", 1)
+}
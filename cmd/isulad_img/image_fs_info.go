@@ -46,6 +46,8 @@ type FilesystemUsage struct {
 	// This may not equal InodesCapacity - InodesAvailable because the underlying
 	// filesystem may also be used for purposes other than storing images.
 	InodesUsed *UInt64Value `json:"inodes_used,omitempty"`
+	// LimitBytes is the size limit of a container filesystem, not set if it is not limited.
+	LimitBytes *UInt64Value `json:"limit_bytes,omitempty"`
 }
 
 // ImageFsInfoResponse provides filesystem usage information.
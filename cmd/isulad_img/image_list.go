@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/containers/image/types"
+	cstorage "github.com/containers/storage"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -28,6 +29,8 @@ import (
 type listImagesResponse struct {
 	// List of images.
 	Images []*Image `json:"images,omitempty"`
+	// Total is the number of images before pagination
+	Total int `json:"total,omitempty"`
 }
 
 // getUserFromImage gets uid or user name
@@ -56,12 +59,19 @@ func imagesHandler(c *cli.Context) error {
 		return err
 	}
 
+	lopts := &listImagesOptions{
+		sortBy:     c.String("sort"),
+		descending: c.Bool("desc"),
+		offset:     c.Int("offset"),
+		limit:      c.Int("limit"),
+	}
+
 	var resp *listImagesResponse
 	sockAddr, err := isDaemonInstanceExist(defaultInfoFile)
 	if strings.Contains(err.Error(), daemonInstanceExist) {
-		resp, err = grpcCliImages(sockAddr, filter, c.Bool("check"))
+		resp, err = grpcCliImages(sockAddr, filter, c.Bool("check"), lopts)
 	} else if os.IsNotExist(err) {
-		resp, err = listImages(gopts, filter, c.Bool("check"))
+		resp, err = listImages(gopts, filter, c.Bool("check"), lopts)
 	}
 	if err != nil {
 		return err
@@ -76,14 +86,53 @@ func imagesHandler(c *cli.Context) error {
 	return err
 }
 
-func listImages(gopts *globalOptions, filter string, check bool) (*listImagesResponse, error) {
+// imageFromSpec builds the image summary returned by ListImages
+func imageFromSpec(store cstorage.Store, result *ImageBasicSpec) (*Image, error) {
+	imageConfig, err := getImageConf(store, result.ID)
+	if err != nil {
+		return nil, err
+	}
+	healthcheck, err := getHealthcheck(store, result.ID)
+	if err != nil {
+		return nil, err
+	}
+	resImg := &Image{
+		ID:          result.ID,
+		RepoTags:    result.RepoTags,
+		RepoDigests: result.RepoDigests,
+		Created:     result.Created,
+		Loaded:      result.Loaded,
+		LastUsed:    result.LastUsed,
+		Pinned:      result.Pinned,
+		ImageSpec:   imageConfig,
+		Healthcheck: healthcheck,
+	}
+	uid, username := getUserFromImage(result.User)
+	if uid != nil {
+		resImg.UID = &Int64Value{Value: *uid}
+	}
+	resImg.Username = username
+	if result.Size != nil {
+		resImg.Size = *result.Size
+	}
+	return resImg, nil
+}
+
+func listImages(gopts *globalOptions, filter string, check bool, lopts *listImagesOptions) (*listImagesResponse, error) {
+	if lopts == nil {
+		lopts = &listImagesOptions{}
+	}
+	if err := lopts.validate(); err != nil {
+		return nil, err
+	}
+
 	imageService, err := getImageService(gopts)
 	if err != nil {
 		return nil, err
 	}
 
 	if check {
-		err = imageService.IntegrationCheck(&types.SystemContext{})
+		err = imageService.IntegrationCheck(&types.SystemContext{}, gopts.IntegrationCheck)
 		if err != nil {
 			return nil, err
 		}
@@ -94,39 +143,35 @@ func listImages(gopts *globalOptions, filter string, check bool) (*listImagesRes
 		return nil, err
 	}
 
-	results, err := imageService.GetAllImages(&types.SystemContext{}, filter)
-	if err != nil {
-		return nil, err
-	}
-
-	resp := &listImagesResponse{}
-	for _, result := range results {
-		imageConfig, err2 := getImageConf(store, result.ID)
-		if err2 != nil {
-			return nil, err2
-		}
-		healthcheck, err2 := getHealthcheck(store, result.ID)
-		if err2 != nil {
-			return nil, err2
-		}
-		resImg := &Image{
-			ID:          result.ID,
-			RepoTags:    result.RepoTags,
-			RepoDigests: result.RepoDigests,
-			Created:     result.Created,
-			Loaded:      result.Loaded,
-			ImageSpec:   imageConfig,
-			Healthcheck: healthcheck,
+	var images []*Image
+	if filter == "" {
+		images, err = gImageCache.list(store, func(id string) (*Image, error) {
+			result, err := imageService.GetOneImage(&types.SystemContext{}, id)
+			if err != nil {
+				return nil, err
+			}
+			return imageFromSpec(store, result)
+		})
+		if err != nil {
+			return nil, err
 		}
-		uid, username := getUserFromImage(result.User)
-		if uid != nil {
-			resImg.UID = &Int64Value{Value: *uid}
+	} else {
+		results, err := imageService.GetAllImages(&types.SystemContext{}, filter)
+		if err != nil {
+			return nil, err
 		}
-		resImg.Username = username
-		if result.Size != nil {
-			resImg.Size = *result.Size
+		for i := range results {
+			image, err := imageFromSpec(store, &results[i])
+			if err != nil {
+				return nil, err
+			}
+			images = append(images, image)
 		}
-		resp.Images = append(resp.Images, resImg)
+	}
+
+	resp := &listImagesResponse{
+		Images: lopts.apply(images),
+		Total:  len(images),
 	}
 
 	logrus.Debugf("listImagesResponse: %+v", resp)
@@ -152,5 +197,21 @@ var imagesCmd = cli.Command{
 			Name:  "check",
 			Usage: "enable check image integrity",
 		},
+		cli.StringFlag{
+			Name:  "sort",
+			Usage: "Sort images by id, name, created, loaded or size",
+		},
+		cli.BoolFlag{
+			Name:  "desc",
+			Usage: "Sort images in descending order",
+		},
+		cli.IntFlag{
+			Name:  "offset",
+			Usage: "Number of images to skip",
+		},
+		cli.IntFlag{
+			Name:  "limit",
+			Usage: "Maximum number of images to list, 0 means no limit",
+		},
 	},
 }
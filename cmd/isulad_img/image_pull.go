@@ -25,10 +25,16 @@ import (
 )
 
 type pullOptions struct {
-	username  string
-	password  string
-	certDir   string
-	tlsVerify bool
+	username string
+	password string
+	// tokens from the request, used instead of stored credentials if set
+	identityToken string
+	registryToken string
+	certDir       string
+	tlsVerify     bool
+	// limits of this pull, 0 means use daemon settings
+	maxBytesPerSec       int64
+	maxParallelDownloads uint
 }
 
 func decodeAuth(s string) (string, string, error) {
@@ -46,78 +52,132 @@ func decodeAuth(s string) (string, string, error) {
 	return username, password, nil
 }
 
-func imagePull(gopts *globalOptions, popts *pullOptions, image string) (string, error) {
+// pullResult is the result of a pull shared by all callers pulling the same image
+type pullResult struct {
+	imageRef string
+	// attempts made to pull the image, including retries and other registries tried
+	attempts int
+}
+
+func imagePull(gopts *globalOptions, popts *pullOptions, image string) (*pullResult, error) {
 	imageService, err := getImageService(gopts)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// print the download report to stderr for debug
 	options := &copy.Options{
-		ReportWriter: os.Stderr,
+		ReportWriter:         os.Stderr,
+		MaxParallelDownloads: getDaemonConfig().MaxParallelDownloads,
+	}
+	if popts.maxParallelDownloads > 0 {
+		options.MaxParallelDownloads = popts.maxParallelDownloads
 	}
 
+	stagingDir := partialBlobStagingDir(gopts)
+	cleanupPartialBlobs(stagingDir, gopts.PartialBlobMaxAge)
+
 	options.SourceCtx = &types.SystemContext{
 		DockerCertPath:              popts.certDir,
 		DockerInsecureSkipTLSVerify: types.NewOptionalBool(!popts.tlsVerify),
 		AuthFilePath:                defaultAuthFilePath(),
+		DockerBlobStagingDir:        stagingDir,
+		DockerTransportHook:         configureTransport,
 	}
+	setupRegistryCerts(gopts, options.SourceCtx)
 
 	// Specifying a username indicates the user intends to send authentication to the registry.
-	if popts.username != "" {
+	if popts.username != "" || popts.identityToken != "" || popts.registryToken != "" {
 		options.SourceCtx.DockerAuthConfig = &types.DockerAuthConfig{
-			Username: popts.username,
-			Password: popts.password,
+			Username:      popts.username,
+			Password:      popts.password,
+			IdentityToken: popts.identityToken,
+			RegistryToken: popts.registryToken,
+		}
+	} else {
+		// Identity tokens from credential stores may be rotated by registry while pulling
+		options.SourceCtx.DockerIdentityTokenUpdated = func(registry, identityToken string) {
+			saveIdentityToken(&types.SystemContext{AuthFilePath: defaultAuthFilePath()}, registry, identityToken)
 		}
 	}
 
 	var (
-		pulled string
+		pulled   string
+		attempts int
 	)
 	images, err := imageService.ParseImageNames(image)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	dstImage := image
 	for _, srcImage := range images {
-		var tmpImg types.Image
-		tmpImg, err = imageService.InitImage(srcImage, options)
-		if err != nil {
-			logrus.Debugf("error preparing image %s: %v", srcImage.name, err)
-			continue
-		}
-
-		var storedImage *ImageBasicSpec
-		storedImage, err = imageService.GetOneImage(&types.SystemContext{}, dstImage)
-		if err == nil {
-			tmpImgConfigDigest := tmpImg.ConfigInfo().Digest
-			if tmpImgConfigDigest.String() == "" {
-				logrus.Debugf("image config digest is empty, re-pulling image")
-			} else if tmpImgConfigDigest.String() == storedImage.ConfigDigest.String() {
-				logrus.Debugf("image %s already in store, skipping pull", dstImage)
-				pulled = dstImage
-				break
+		// Candidates may come from different registries, each needs its own credentials
+		srcOptions := *options
+		srcCtx := *options.SourceCtx
+		srcOptions.SourceCtx = &srcCtx
+		if domain, _ := parseDockerDomain(srcImage.name); domain != "" {
+			if err = setupCredentials(&srcCtx, domain); err != nil {
+				continue
 			}
-			logrus.Debugf("image in store has different ID, re-pulling %s", dstImage)
 		}
 
-		_, err = imageService.PullImage(&types.SystemContext{}, srcImage, dstImage, options)
+		var n int
+		n, err = retry(daemonCtx, gopts.RetryPolicy, "Pull image "+srcImage.name, func() error {
+			return pullOneImage(imageService, srcImage, dstImage, &srcOptions, popts.maxBytesPerSec)
+		})
+		attempts += n
 		if err != nil {
-			logrus.Debugf("error pulling image %s: %v", srcImage.name, err)
 			continue
 		}
 		pulled = dstImage
 		break
 	}
 	if pulled == "" && err != nil {
-		return "", err
+		return nil, err
 	}
 	status, err := imageService.GetOneImage(&types.SystemContext{}, pulled)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	fmt.Print(status.ID)
-	return status.ID, nil
+	return &pullResult{
+		imageRef: status.ID,
+		attempts: attempts,
+	}, nil
+}
+
+// pullOneImage pulls srcImage to dstImage, skips if the same image is already in store
+func pullOneImage(imageService ImageServer, srcImage parsedImageNames, dstImage string, options *copy.Options, maxBytesPerSec int64) error {
+	tmpImg, err := imageService.InitImage(srcImage, options)
+	if err != nil {
+		logrus.Debugf("error preparing image %s: %v", srcImage.name, err)
+		return err
+	}
+
+	storedImage, err := imageService.GetOneImage(&types.SystemContext{}, dstImage)
+	if err == nil {
+		if img, err2 := imageService.GetStore().Image(storedImage.ID); err2 == nil {
+			if reason, ok := isQuarantined(img); ok {
+				return fmt.Errorf("image %s is quarantined (%s), repair it with verify --repair or remove it before pulling again", dstImage, reason)
+			}
+		}
+		tmpImgConfigDigest := tmpImg.ConfigInfo().Digest
+		if tmpImgConfigDigest.String() == "" {
+			logrus.Debugf("image config digest is empty, re-pulling image")
+		} else if tmpImgConfigDigest.String() == storedImage.ConfigDigest.String() {
+			logrus.Debugf("image %s already in store, skipping pull", dstImage)
+			return nil
+		}
+		logrus.Debugf("image in store has different ID, re-pulling %s", dstImage)
+	}
+
+	_, err = imageService.PullImage(&types.SystemContext{}, srcImage, dstImage, options, maxBytesPerSec)
+	if err != nil {
+		logrus.Debugf("error pulling image %s: %v", srcImage.name, err)
+		return err
+	}
+
+	return nil
 }
@@ -24,13 +24,13 @@ import (
 type removeImageResponse struct {
 }
 
-func imageRemove(gopts *globalOptions, image string) error {
+func imageRemove(gopts *globalOptions, image string, force bool) error {
 	imageService, err := getImageService(gopts)
 	if err != nil {
 		return err
 	}
 
-	err = imageService.UnrefImage(&types.SystemContext{}, image)
+	err = imageService.UnrefImage(&types.SystemContext{}, image, force)
 	if err != nil {
 		logrus.Debugf("error deleting image %s: %v", image, err)
 		return err
@@ -46,3 +46,17 @@ func imageRemove(gopts *globalOptions, image string) error {
 	fmt.Printf("%s\n", data)
 	return err
 }
+
+// imagePin pins or unpins the image
+func imagePin(gopts *globalOptions, image string, pinned bool) error {
+	imageService, err := getImageService(gopts)
+	if err != nil {
+		return err
+	}
+
+	if err := imageService.PinImage(image, pinned); err != nil {
+		logrus.Debugf("error pinning image %s: %v", image, err)
+		return err
+	}
+	return nil
+}
@@ -50,6 +50,10 @@ type Image struct {
 	Created *time.Time `json:"created,omitempty"`
 	// Loaded is the combined date and time at which the image was pulled, formatted as defined by RFC 3339, section 5.6.
 	Loaded *time.Time `json:"Loaded,omitempty"`
+	// LastUsed is the last time a container was prepared from the image, formatted as defined by RFC 3339, section 5.6.
+	LastUsed *time.Time `json:"last_used,omitempty"`
+	// Pinned images can not be removed without force, nor be evicted
+	Pinned bool `json:"pinned,omitempty"`
 
 	ImageSpec *v1.Image `json:"Spec,omitempty"`
 
@@ -105,6 +109,8 @@ func imageStatus(gopts *globalOptions, image string) (*imageStatusResponse, erro
 			Size:        *status.Size,
 			Created:     &created,
 			Loaded:      &loaded,
+			LastUsed:    status.LastUsed,
+			Pinned:      status.Pinned,
 			ImageSpec:   imageConfig,
 			Healthcheck: healthcheck,
 		},
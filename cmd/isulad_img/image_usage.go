@@ -0,0 +1,66 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"time"
+
+	"github.com/containers/storage"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// lastUsedFlag is the image flag recording the last time a container
+	// was prepared from the image, in RFC 3339 format
+	lastUsedFlag = "last-used"
+	// pinnedFlag is the image flag set on images protected from removal
+	// without force and from eviction
+	pinnedFlag = "pinned"
+)
+
+// imageLastPrepared returns the last time a container was prepared from the
+// image, nil if never
+func imageLastPrepared(image *storage.Image) *time.Time {
+	v, ok := image.Flags[lastUsedFlag].(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// imageLastUsed returns the last time the image was used, which is the later
+// one of the time it was loaded and the time a container was last prepared from it
+func imageLastUsed(image *storage.Image) time.Time {
+	lastUsed := image.Loaded
+	if t := imageLastPrepared(image); t != nil && t.After(lastUsed) {
+		lastUsed = *t
+	}
+	return lastUsed
+}
+
+func isPinned(image *storage.Image) bool {
+	pinned, ok := image.Flags[pinnedFlag].(bool)
+	return ok && pinned
+}
+
+// stampLastUsed records now as the last time the image was used
+func stampLastUsed(store storage.Store, id string) {
+	if err := store.SetImageFlag(id, lastUsedFlag, time.Now().UTC().Format(time.RFC3339Nano)); err != nil {
+		logrus.Warnf("Failed to record last used time of image %s: %v", id, err)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pb "isula-image/isula"
+)
+
+func TestPinImage(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	defer useTestImageService(svc)()
+
+	s := &grpcImageService{daemonOptions: daemonOptions{gopts: &globalOptions{}}}
+	name := "docker.io/library/busybox:latest"
+	image := createTestImage(t, svc.store, name, "base")
+	pbImage := &pb.ImageSpec{Image: name}
+
+	if _, err := s.PinImage(context.Background(), &pb.PinImageRequest{Image: pbImage}); err != nil {
+		t.Fatalf("failed to pin image: %v", err)
+	}
+	status, err := s.ImageStatus(context.Background(), &pb.ImageStatusRequest{Image: pbImage})
+	if err != nil || !status.Image.Pinned {
+		t.Errorf("got status %+v, %v, want image pinned", status, err)
+	}
+
+	resp, err := s.RemoveImage(context.Background(), &pb.RemoveImageRequest{Image: pbImage})
+	if err == nil || resp.Cc == 0 || !strings.Contains(resp.Errmsg, "pinned") {
+		t.Errorf("remove of pinned image without force should fail, got %+v, %v", resp, err)
+	}
+	if _, err := svc.store.Image(image.ID); err != nil {
+		t.Fatalf("pinned image should be kept: %v", err)
+	}
+
+	if _, err := s.UnpinImage(context.Background(), &pb.UnpinImageRequest{Image: pbImage}); err != nil {
+		t.Fatalf("failed to unpin image: %v", err)
+	}
+	status, err = s.ImageStatus(context.Background(), &pb.ImageStatusRequest{Image: pbImage})
+	if err != nil || status.Image.Pinned {
+		t.Errorf("got status %+v, %v, want image unpinned", status, err)
+	}
+	if _, err := s.RemoveImage(context.Background(), &pb.RemoveImageRequest{Image: pbImage}); err != nil {
+		t.Errorf("failed to remove unpinned image: %v", err)
+	}
+	if _, err := svc.store.Image(image.ID); err == nil {
+		t.Errorf("unpinned image should be removed")
+	}
+}
+
+func TestRemovePinnedImageWithForce(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	defer useTestImageService(svc)()
+
+	s := &grpcImageService{daemonOptions: daemonOptions{gopts: &globalOptions{}}}
+	name := "docker.io/library/busybox:latest"
+	image := createTestImage(t, svc.store, name, "base")
+	pbImage := &pb.ImageSpec{Image: name}
+
+	if _, err := s.PinImage(context.Background(), &pb.PinImageRequest{Image: pbImage}); err != nil {
+		t.Fatalf("failed to pin image: %v", err)
+	}
+	if _, err := s.RemoveImage(context.Background(), &pb.RemoveImageRequest{Image: pbImage, Force: true}); err != nil {
+		t.Errorf("failed to remove pinned image with force: %v", err)
+	}
+	if _, err := svc.store.Image(image.ID); err == nil {
+		t.Errorf("pinned image should be removed with force")
+	}
+}
+
+func TestImageLastUsed(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	defer useTestImageService(svc)()
+
+	s := &grpcImageService{daemonOptions: daemonOptions{gopts: &globalOptions{}}}
+	name := "docker.io/library/busybox:latest"
+	image := createTestImage(t, svc.store, name, "base")
+	pbImage := &pb.ImageSpec{Image: name}
+
+	status, err := s.ImageStatus(context.Background(), &pb.ImageStatusRequest{Image: pbImage})
+	if err != nil || status.Image.LastUsed != "" {
+		t.Errorf("got status %+v, %v, want no last used time", status, err)
+	}
+
+	if _, err := containerPrepare(&globalOptions{}, nil, name, "c1", "", &ContainerCreateOptions{}); err != nil {
+		t.Fatalf("failed to prepare container: %v", err)
+	}
+	img, err := svc.store.Image(image.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lastUsed := imageLastPrepared(img)
+	if lastUsed == nil {
+		t.Fatalf("last used time should be recorded by container prepare")
+	}
+	if !imageLastUsed(img).Equal(*lastUsed) {
+		t.Errorf("got last used %v, want %v stamped after loaded", imageLastUsed(img), *lastUsed)
+	}
+
+	status, err = s.ImageStatus(context.Background(), &pb.ImageStatusRequest{Image: pbImage})
+	if err != nil || status.Image.LastUsed != lastUsed.Format(time.RFC3339Nano) {
+		t.Errorf("got status %+v, %v, want last used %v", status, err, lastUsed)
+	}
+	list, err := s.ListImages(context.Background(), &pb.ListImagesRequest{})
+	if err != nil || len(list.Images) != 1 || list.Images[0].LastUsed != lastUsed.Format(time.RFC3339Nano) {
+		t.Errorf("got images %+v, %v, want last used %v", list, err, lastUsed)
+	}
+}
+
+func TestUntagPinnedImage(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	defer useTestImageService(svc)()
+
+	s := &grpcImageService{daemonOptions: daemonOptions{gopts: &globalOptions{}}}
+	name, other := "docker.io/library/busybox:latest", "docker.io/library/busybox:v1"
+	image := createTestImage(t, svc.store, name, "base")
+	if err := svc.store.AddName(image.ID, other); err != nil {
+		t.Fatal(err)
+	}
+	pbImage := &pb.ImageSpec{Image: other}
+
+	if _, err := s.PinImage(context.Background(), &pb.PinImageRequest{Image: pbImage}); err != nil {
+		t.Fatalf("failed to pin image: %v", err)
+	}
+	resp, err := s.RemoveImage(context.Background(), &pb.RemoveImageRequest{Image: pbImage})
+	if err == nil || resp.Cc == 0 || !strings.Contains(resp.Errmsg, "pinned") {
+		t.Errorf("remove of a name of pinned image without force should fail, got %+v, %v", resp, err)
+	}
+	if img, err := svc.store.Image(image.ID); err != nil || len(img.Names) != 2 {
+		t.Errorf("names of pinned image should be kept, got %+v, %v", img, err)
+	}
+
+	if _, err := s.RemoveImage(context.Background(), &pb.RemoveImageRequest{Image: pbImage, Force: true}); err != nil {
+		t.Errorf("failed to remove a name of pinned image with force: %v", err)
+	}
+	img, err := svc.store.Image(image.ID)
+	if err != nil || len(img.Names) != 1 || img.Names[0] != name {
+		t.Errorf("got image %+v, %v, want only name %s left", img, err, name)
+	}
+}
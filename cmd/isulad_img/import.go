@@ -14,7 +14,6 @@
 package main
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -63,7 +62,7 @@ func importImage(gopts *globalOptions, input string, destTag string) (string, er
 		return "", fmt.Errorf("Invalid tag %s: %v", destTag, err)
 	}
 
-	_, err = copy.Image(context.Background(), policyContext, destRef, srcRef, &copy.Options{
+	_, err = copy.Image(daemonCtx, policyContext, destRef, srcRef, &copy.Options{
 		ReportWriter: os.Stdout,
 	})
 	if err != nil {
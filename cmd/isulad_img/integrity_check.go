@@ -0,0 +1,105 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/containers/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// integrationCheckOptions controls how IntegrationCheck checks layers
+type integrationCheckOptions struct {
+	// Workers is the number of layers checked in parallel, number of CPUs if not set
+	Workers int
+	// Fast checks only metadata of layers, the contents are checked in background
+	Fast bool
+}
+
+// set while a background deep check is running, only one runs at a time
+var gDeepCheckRunning int32
+
+func (o integrationCheckOptions) workers() int {
+	if o.Workers > 0 {
+		return o.Workers
+	}
+	return runtime.NumCPU()
+}
+
+// imageLayerMap returns layers of every image, including layers mapped for
+// containers with ID mappings, and errors of images whose layer chain is broken.
+func imageLayerMap(store storage.Store, images []storage.Image) (map[string][]string, map[string]error) {
+	layers := make(map[string][]string)
+	broken := make(map[string]error)
+	for i := range images {
+		l, err := imageLayerSet(store, &images[i])
+		if err != nil {
+			broken[images[i].ID] = err
+			continue
+		}
+		layers[images[i].ID] = l
+	}
+	return layers, broken
+}
+
+// deepCheckInBackground checks contents of the layers of images one layer at a
+// time, so that the layer store is not locked for long. Images with a broken
+// layer are quarantined instead of deleted, as containers may be running on them.
+func deepCheckInBackground(store storage.Store, layers map[string][]string) {
+	if !atomic.CompareAndSwapInt32(&gDeepCheckRunning, 0, 1) {
+		logrus.Infof("Deep check of layers already running")
+		return
+	}
+
+	started := goBackground("deep check of layers", func() {
+		defer atomic.StoreInt32(&gDeepCheckRunning, 0)
+
+		logrus.Infof("Deep check of layers started")
+		results := make(map[string]error)
+		for imageID, ids := range layers {
+			for _, id := range ids {
+				if backgroundCtx.Err() != nil {
+					logrus.Infof("Deep check of layers cancelled")
+					return
+				}
+				err, checked := results[id]
+				if !checked {
+					err = store.CheckLayers([]string{id}, 1, false)[id]
+					results[id] = err
+				}
+				if err == nil {
+					continue
+				}
+				if errors.Cause(err) == storage.ErrLayerUnknown {
+					// Removed since the check started
+					break
+				}
+				reason := fmt.Sprintf("layer %s check failed with: %v", id, err)
+				logrus.Errorf("Quarantine image %s: %s", imageID, reason)
+				if err := store.SetImageFlag(imageID, quarantineFlag, reason); err != nil {
+					logrus.Errorf("Failed to quarantine image %s: %v", imageID, err)
+				}
+				break
+			}
+		}
+		logrus.Infof("Deep check of layers finished")
+	})
+	if !started {
+		atomic.StoreInt32(&gDeepCheckRunning, 0)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/containers/image/types"
+	"github.com/containers/storage"
+)
+
+// checkCachePath returns the file layer checks are cached in across runs
+func checkCachePath(store storage.Store) string {
+	return filepath.Join(store.RunRoot(), fmt.Sprintf("%x.json", sha256.Sum256([]byte(store.RunRoot()))))
+}
+
+// restartCheck starts a new run of layer checks as a restarted daemon does
+func restartCheck(store storage.Store) {
+	store.CleanupCheckedLayers()
+	store.GetCheckedLayers()
+}
+
+func TestCheckLayersParallel(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	var ids []string
+	for i := 0; i < 4; i++ {
+		image := createTestImage(t, svc.store, fmt.Sprintf("test:%d", i), fmt.Sprintf("base%d", i), fmt.Sprintf("top%d", i))
+		layer, err := svc.store.Layer(image.TopLayer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, layer.Parent, layer.ID)
+	}
+	corruptTestLayer(t, svc.store, ids[3], "t0p1")
+
+	restartCheck(svc.store)
+	errs := svc.store.CheckLayers(append(ids, ids...), 4, false)
+	if len(errs) != 1 || errs[ids[3]] == nil {
+		t.Errorf("expect only layer %s to fail, got %v", ids[3], errs)
+	}
+}
+
+func TestCheckLayersCache(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "test:latest", "base", "top")
+	ids := []string{image.TopLayer}
+
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 2, false); len(errs) != 0 {
+		t.Fatalf("check of intact layer failed: %v", errs)
+	}
+
+	// Corrupting contents in place changes no modification time, the result
+	// is taken from this run and then from the cache of the earlier run
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+	if errs := svc.store.CheckLayers(ids, 2, false); len(errs) != 0 {
+		t.Errorf("layer checked in this run should be skipped, got %v", errs)
+	}
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 2, false); len(errs) != 0 {
+		t.Errorf("layer checked in earlier run should be skipped, got %v", errs)
+	}
+
+	// Cached results expire
+	data, err := ioutil.ReadFile(checkCachePath(svc.store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expired []string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			t.Fatalf("unexpected line %q in check cache", line)
+		}
+		fields[2] = fmt.Sprint(time.Now().Add(-48 * time.Hour).Unix())
+		expired = append(expired, strings.Join(fields, " "))
+	}
+	if err := ioutil.WriteFile(checkCachePath(svc.store), []byte(strings.Join(expired, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 2, false); errs[image.TopLayer] == nil {
+		t.Errorf("layer with expired check should be checked again")
+	}
+}
+
+func TestCheckLayersCacheModified(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "test:latest", "base", "top")
+	ids := []string{image.TopLayer}
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 1, false); len(errs) != 0 {
+		t.Fatalf("check of intact layer failed: %v", errs)
+	}
+
+	// Applying a diff rewrites the tar-split data of the layer
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+	later := time.Now().Add(time.Minute)
+	tarSplit := filepath.Join(svc.store.GraphRoot(), "vfs-layers", image.TopLayer+".tar-split.gz")
+	if err := os.Chtimes(tarSplit, later, later); err != nil {
+		t.Fatal(err)
+	}
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 1, false); errs[image.TopLayer] == nil {
+		t.Errorf("modified layer should be checked again")
+	}
+}
+
+func TestCheckLayersFast(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "test:latest", "base", "top")
+	ids := []string{image.TopLayer}
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 1, true); len(errs) != 0 {
+		t.Errorf("fast check should not read contents, got %v", errs)
+	}
+	// Metadata checks are not taken as checks of contents
+	if errs := svc.store.CheckLayers(ids, 1, false); errs[image.TopLayer] == nil {
+		t.Errorf("contents should be checked after fast check")
+	}
+
+	if err := os.RemoveAll(filepath.Join(svc.store.GraphRoot(), "vfs", "dir", image.TopLayer)); err != nil {
+		t.Fatal(err)
+	}
+	restartCheck(svc.store)
+	if errs := svc.store.CheckLayers(ids, 1, true); errs[image.TopLayer] == nil {
+		t.Errorf("fast check should find missing contents")
+	}
+}
+
+func TestIntegrationCheckFast(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "test:latest", "base", "top")
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+
+	if err := svc.IntegrationCheck(&types.SystemContext{}, integrationCheckOptions{Workers: 2, Fast: true}); err != nil {
+		t.Fatalf("integration check failed: %v", err)
+	}
+	// Contents are checked in background, the image is quarantined instead of deleted
+	for i := 0; i < 100 && atomic.LoadInt32(&gDeepCheckRunning) != 0; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	img, err := svc.store.Image(image.ID)
+	if err != nil {
+		t.Fatalf("image should be kept: %v", err)
+	}
+	if _, ok := isQuarantined(img); !ok {
+		t.Errorf("image with corrupted layer should be quarantined")
+	}
+}
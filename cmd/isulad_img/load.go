@@ -14,7 +14,6 @@
 package main
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -115,7 +114,7 @@ func loadImage(gopts *globalOptions, lopts *loadOptions) (string, error) {
 			return output, fmt.Errorf("Invalid tag %s: %v", destTag, err)
 		}
 
-		_, err = copy.Image(context.Background(), policyContext, destRef, srcRef, &copy.Options{
+		_, err = copy.Image(daemonCtx, policyContext, destRef, srcRef, &copy.Options{
 			ReportWriter: os.Stdout,
 		})
 		if err != nil {
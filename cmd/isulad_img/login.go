@@ -15,29 +15,64 @@ package main
 
 import (
 	"context"
+	"errors"
 	"strings"
 
+	pb "isula-image/isula"
+
 	"github.com/containers/image/docker"
-	"github.com/containers/image/pkg/docker/config"
 	"github.com/containers/image/types"
 )
 
-func loginRegistry(gopts *globalOptions, sys *types.SystemContext, username string, password string, server string) error {
+// loginAuthConfig returns credentials in login request, one of username and
+// password, identity token (refresh token) and registry token is required.
+func loginAuthConfig(req *pb.LoginRequest) (types.DockerAuthConfig, error) {
+	identityToken := req.IdentityToken
+	if req.RefreshToken != "" {
+		if identityToken != "" && identityToken != req.RefreshToken {
+			return types.DockerAuthConfig{}, errors.New("identity token and refresh token are different")
+		}
+		identityToken = req.RefreshToken
+	}
+
+	switch {
+	case identityToken != "" || req.RegistryToken != "":
+		if req.Password != "" {
+			return types.DockerAuthConfig{}, errors.New("password can not be used with tokens")
+		}
+		return types.DockerAuthConfig{
+			Username:      req.Username,
+			IdentityToken: identityToken,
+			RegistryToken: req.RegistryToken,
+		}, nil
+	case req.Username != "" && req.Password != "":
+		return types.DockerAuthConfig{
+			Username: req.Username,
+			Password: req.Password,
+		}, nil
+	default:
+		return types.DockerAuthConfig{}, errors.New("Lack infomation for login")
+	}
+}
+
+func loginRegistry(gopts *globalOptions, sys *types.SystemContext, auth types.DockerAuthConfig, server string) error {
 	svc, err := getImageService(gopts)
 	if err != nil {
 		return err
 	}
 
+	sys.DockerTransportHook = configureTransport
+	setupRegistryCerts(gopts, sys)
 	serverAddr := strings.Split(server, "/")[0]
 	if secure := svc.IsSecureIndex(serverAddr); !secure {
 		sys.DockerInsecureSkipTLSVerify = types.NewOptionalBool(true)
 	}
 
-	if err := docker.CheckAuth(context.Background(), sys, username, password, serverAddr); err != nil {
+	if err := docker.CheckAuthConfig(context.Background(), sys, auth, serverAddr); err != nil {
 		return err
 	}
 
-	if err := config.SetAuthentication(sys, serverAddr, username, password); err != nil {
+	if err := getCredentialStore(sys, serverAddr).store(serverAddr, auth); err != nil {
 		return err
 	}
 
@@ -0,0 +1,48 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-22
+
+package main
+
+import (
+	"testing"
+
+	pb "isula-image/isula"
+)
+
+func TestLoginAuthConfig(t *testing.T) {
+	auth, err := loginAuthConfig(&pb.LoginRequest{Server: "r.io", Username: "u", Password: "p"})
+	if err != nil || auth.Username != "u" || auth.Password != "p" {
+		t.Errorf("unexpected auth for password login: %+v, %v", auth, err)
+	}
+
+	auth, err = loginAuthConfig(&pb.LoginRequest{Server: "r.io", RefreshToken: "rt"})
+	if err != nil || auth.IdentityToken != "rt" {
+		t.Errorf("expect refresh token used as identity token, got %+v, %v", auth, err)
+	}
+
+	auth, err = loginAuthConfig(&pb.LoginRequest{Server: "r.io", RegistryToken: "bt"})
+	if err != nil || auth.RegistryToken != "bt" {
+		t.Errorf("unexpected auth for registry token login: %+v, %v", auth, err)
+	}
+
+	invalid := []*pb.LoginRequest{
+		{Server: "r.io", Username: "u"},
+		{Server: "r.io", IdentityToken: "a", RefreshToken: "b"},
+		{Server: "r.io", Password: "p", IdentityToken: "a"},
+	}
+	for _, req := range invalid {
+		if _, err := loginAuthConfig(req); err == nil {
+			t.Errorf("expect error for %+v", req)
+		}
+	}
+}
@@ -17,12 +17,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/containers/image/pkg/docker/config"
 	"github.com/containers/image/types"
 )
 
 func logoutRegistry(sys *types.SystemContext, serverAddr string) error {
-	err := config.RemoveAuthentication(sys, serverAddr)
+	err := getCredentialStore(sys, serverAddr).erase(serverAddr)
 	if err == nil {
 		fmt.Printf("Removing login credentials for %v\n", serverAddr)
 		return nil
@@ -133,6 +133,8 @@ func createApp() *cli.App {
 	app.Commands = []cli.Command{
 		infoCmd,
 		imagesCmd,
+		verifyCmd,
+		dfCmd,
 		daemonCmd,
 	}
 	return app
@@ -180,7 +182,7 @@ func getImageService(gopts *globalOptions) (ImageServer, error) {
 		return nil, err
 	}
 
-	gImageService, err = InitImageService(context.Background(), store, defaultTransport,
+	gImageService, err = InitImageService(daemonCtx, store, defaultTransport,
 		gopts.InsecureRegistries, gopts.Registries)
 	if err != nil {
 		return nil, err
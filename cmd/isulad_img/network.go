@@ -0,0 +1,175 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-19
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultConnectTimeout = 30 * time.Second
+
+func parseProxy(proxy string) (*url.URL, error) {
+	if !strings.Contains(proxy, "://") {
+		proxy = "http://" + proxy
+	}
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %v", proxy, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q: no host", proxy)
+	}
+	return u, nil
+}
+
+func validateProxy(proxy string) error {
+	if proxy == "" {
+		return nil
+	}
+	_, err := parseProxy(proxy)
+	return err
+}
+
+// matchNoProxy checks if host matches the comma separated no-proxy list. An entry
+// matches itself and its subdomains, it can also be an IP, a CIDR or "*".
+func matchNoProxy(host, noProxy string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		entry = strings.TrimPrefix(entry, "*")
+		if host == strings.TrimPrefix(entry, ".") || strings.HasSuffix(host, "."+strings.TrimPrefix(entry, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// proxyFunc returns the proxy function for requests to registry, nil if
+// proxy is not configured in daemon config.
+func (c *daemonConfig) proxyFunc(registry string) (func(*http.Request) (*url.URL, error), error) {
+	if p := c.registry(registry).Proxy; p != "" {
+		u, err := parseProxy(p)
+		if err != nil {
+			return nil, err
+		}
+		return http.ProxyURL(u), nil
+	}
+
+	if c.HTTPProxy == "" && c.HTTPSProxy == "" && c.NoProxy == "" {
+		return nil, nil
+	}
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxy := c.HTTPProxy
+		if req.URL.Scheme == "https" {
+			proxy = c.HTTPSProxy
+		}
+		if proxy == "" || matchNoProxy(req.URL.Host, c.NoProxy) {
+			return nil, nil
+		}
+		return parseProxy(proxy)
+	}, nil
+}
+
+// timeoutConn fails a read if no data is received in timeout
+type timeoutConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c *timeoutConn) Read(b []byte) (int, error) {
+	if err := c.Conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(b)
+}
+
+// configureTransport applies network settings of daemon config to the transport
+// used to talk to registry.
+func configureTransport(registry string, tr *http.Transport) error {
+	config := getDaemonConfig()
+	reg := config.registry(registry)
+
+	proxy, err := config.proxyFunc(registry)
+	if err != nil {
+		return err
+	}
+	if proxy != nil {
+		tr.Proxy = proxy
+	}
+
+	connectTimeout := time.Duration(config.ConnectTimeout)
+	if reg.ConnectTimeout != 0 {
+		connectTimeout = time.Duration(reg.ConnectTimeout)
+	}
+	readTimeout := time.Duration(config.ReadTimeout)
+	if reg.ReadTimeout != 0 {
+		readTimeout = time.Duration(reg.ReadTimeout)
+	}
+	if connectTimeout == 0 && readTimeout == 0 && len(config.DNSOverrides) == 0 {
+		// Keep the default dialer which honors ALL_PROXY
+		return nil
+	}
+	if connectTimeout == 0 {
+		connectTimeout = defaultConnectTimeout
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   connectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	tr.Dial = nil
+	tr.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if host, port, err := net.SplitHostPort(addr); err == nil {
+			if ip, ok := config.DNSOverrides[host]; ok {
+				addr = net.JoinHostPort(ip, port)
+			}
+		}
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil || readTimeout == 0 {
+			return conn, err
+		}
+		return &timeoutConn{Conn: conn, timeout: readTimeout}, nil
+	}
+	tr.ResponseHeaderTimeout = readTimeout
+
+	return nil
+}
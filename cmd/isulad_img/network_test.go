@@ -0,0 +1,77 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-19
+
+package main
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestMatchNoProxy(t *testing.T) {
+	noProxy := "localhost, .internal.com,example.org:5000,10.0.0.0/8"
+	cases := []struct {
+		host  string
+		match bool
+	}{
+		{"localhost:5000", true},
+		{"hub.internal.com", true},
+		{"internal.com", true},
+		{"example.org", true},
+		{"registry.example.org", true},
+		{"10.1.2.3:443", true},
+		{"11.1.2.3", false},
+		{"docker.io", false},
+		{"notinternal.com", false},
+	}
+
+	for _, c := range cases {
+		if m := matchNoProxy(c.host, noProxy); m != c.match {
+			t.Errorf("expect %v for %s, got %v", c.match, c.host, m)
+		}
+	}
+}
+
+func TestProxyFunc(t *testing.T) {
+	c := &daemonConfig{
+		HTTPSProxy: "proxy.com:3128",
+		NoProxy:    "local.com",
+		Registries: map[string]registryConfig{"quay.io": {Proxy: "http://quay-proxy.com:8080"}},
+	}
+
+	proxy, err := c.proxyFunc("docker.io")
+	if err != nil {
+		t.Fatalf("proxyFunc failed: %v", err)
+	}
+	req, _ := http.NewRequest("GET", "https://registry-1.docker.io/v2/", nil)
+	if u, _ := proxy(req); u == nil || u.Host != "proxy.com:3128" {
+		t.Errorf("expect https proxy, got %v", u)
+	}
+	req, _ = http.NewRequest("GET", "https://hub.local.com/v2/", nil)
+	if u, _ := proxy(req); u != nil {
+		t.Errorf("expect no proxy for no-proxy host, got %v", u)
+	}
+
+	proxy, err = c.proxyFunc("quay.io")
+	if err != nil {
+		t.Fatalf("proxyFunc failed: %v", err)
+	}
+	req, _ = http.NewRequest("GET", "https://quay.io/v2/", nil)
+	if u, _ := proxy(req); u == nil || u.Host != "quay-proxy.com:8080" {
+		t.Errorf("expect registry proxy, got %v", u)
+	}
+
+	if proxy, _ := (&daemonConfig{}).proxyFunc("docker.io"); proxy != nil {
+		t.Errorf("expect environment proxy used if not configured")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-14
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containers/image/docker"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	partialBlobDir           = "partial-blobs"
+	defaultPartialBlobMaxAge = 24 * time.Hour
+)
+
+// partialBlobStagingDir returns the directory to save partially downloaded blobs
+func partialBlobStagingDir(gopts *globalOptions) string {
+	return filepath.Join(gopts.GraphRoot, partialBlobDir)
+}
+
+// cleanupPartialBlobs removes partial blobs not updated for maxAge
+func cleanupPartialBlobs(dir string, maxAge time.Duration) {
+	if maxAge <= 0 {
+		maxAge = defaultPartialBlobMaxAge
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logrus.Warnf("Failed to read partial blob directory %s: %v", dir, err)
+		}
+		return
+	}
+
+	for _, fi := range files {
+		if !strings.HasSuffix(fi.Name(), docker.PartialBlobSuffix) || time.Since(fi.ModTime()) < maxAge {
+			continue
+		}
+		logrus.Infof("Remove stale partial blob %s", fi.Name())
+		if err := os.Remove(filepath.Join(dir, fi.Name())); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("Failed to remove stale partial blob %s: %v", fi.Name(), err)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/image/docker"
+	"github.com/containers/image/pkg/blobinfocache"
+	"github.com/containers/image/types"
+	digest "github.com/opencontainers/go-digest"
+)
+
+// blobRegistry serves one blob, rangeStart decides where a range response
+// starts given the requested offset, -1 ignores ranges
+type blobRegistry struct {
+	blob       []byte
+	rangeStart func(offset int) int
+	ranges     []string
+}
+
+func (r *blobRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.Contains(req.URL.Path, "/blobs/") {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	r.ranges = append(r.ranges, req.Header.Get("Range"))
+
+	var offset int
+	if _, err := fmt.Sscanf(req.Header.Get("Range"), "bytes=%d-", &offset); err != nil || r.rangeStart(offset) < 0 {
+		w.Write(r.blob)
+		return
+	}
+	start := r.rangeStart(offset)
+	w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(r.blob)-1, len(r.blob)))
+	w.WriteHeader(http.StatusPartialContent)
+	w.Write(r.blob[start:])
+}
+
+func TestResumeBlob(t *testing.T) {
+	blob := bytes.Repeat([]byte("0123456789"), 1000)
+	d := digest.FromBytes(blob)
+
+	cases := []struct {
+		name       string
+		rangeStart func(offset int) int
+		ranges     []string
+	}{
+		{"resume", func(offset int) int { return offset }, []string{"bytes=4000-"}},
+		{"range ignored", func(offset int) int { return -1 }, []string{"bytes=4000-"}},
+		// Appending from another offset corrupts the blob, download it again
+		{"range mismatch", func(offset int) int { return offset / 2 }, []string{"bytes=4000-", ""}},
+	}
+	for _, c := range cases {
+		registry := &blobRegistry{blob: blob, rangeStart: c.rangeStart}
+		server := httptest.NewServer(registry)
+
+		dir, err := ioutil.TempDir("", "partial-blob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		partial := docker.PartialBlobPath(dir, d)
+		if err := ioutil.WriteFile(partial, blob[:4000], 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		sys := &types.SystemContext{
+			DockerInsecureSkipTLSVerify: types.OptionalBoolTrue,
+			DockerBlobStagingDir:        dir,
+			AuthFilePath:                filepath.Join(dir, "auth.json"),
+			RegistriesDirPath:           dir,
+		}
+		ref, err := docker.ParseReference("//" + strings.TrimPrefix(server.URL, "http://") + "/test/image@" + d.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		src, err := ref.NewImageSource(context.Background(), sys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rc, _, err := src.GetBlob(context.Background(), types.BlobInfo{Digest: d, Size: int64(len(blob))}, blobinfocache.NoCache)
+		if err != nil {
+			t.Fatalf("%s: get blob failed: %v", c.name, err)
+		}
+		got, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil || !bytes.Equal(got, blob) {
+			t.Errorf("%s: got blob of %d bytes with error %v, want %d bytes", c.name, len(got), err, len(blob))
+		}
+		if strings.Join(registry.ranges, ",") != strings.Join(c.ranges, ",") {
+			t.Errorf("%s: requested ranges %q, want %q", c.name, registry.ranges, c.ranges)
+		}
+		if _, err := os.Stat(partial); !os.IsNotExist(err) {
+			t.Errorf("%s: partial blob should be removed once the blob is read, got %v", c.name, err)
+		}
+
+		src.Close()
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestCleanupPartialBlobs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partial-blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stale := filepath.Join(dir, "sha256-stale"+docker.PartialBlobSuffix)
+	fresh := filepath.Join(dir, "sha256-fresh"+docker.PartialBlobSuffix)
+	other := filepath.Join(dir, "other")
+	for _, f := range []string{stale, fresh, other} {
+		if err := ioutil.WriteFile(f, []byte("data"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	for _, f := range []string{stale, other} {
+		if err := os.Chtimes(f, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cleanupPartialBlobs(dir, time.Hour)
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale partial blob should be removed, got %v", err)
+	}
+	for _, f := range []string{fresh, other} {
+		if _, err := os.Stat(f); err != nil {
+			t.Errorf("%s should be kept, got %v", f, err)
+		}
+	}
+	// Missing directory is not an error
+	cleanupPartialBlobs(filepath.Join(dir, "missing"), 0)
+}
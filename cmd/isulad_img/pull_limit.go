@@ -0,0 +1,193 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-18
+
+package main
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/containers/image/docker/reference"
+	"github.com/containers/image/types"
+)
+
+// size of a single read from a rate limited blob, so that the rate is smooth
+const limitedReadSize = 32 * 1024
+
+// rateLimiter is a token bucket limiting bytes per second, it allows a burst
+// of one second.
+type rateLimiter struct {
+	sync.Mutex
+	rate   int64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate int64) *rateLimiter {
+	return &rateLimiter{rate: rate, last: time.Now()}
+}
+
+// setRate changes the rate, 0 means no limit
+func (l *rateLimiter) setRate(rate int64) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.rate = rate
+	if l.tokens > float64(rate) {
+		l.tokens = float64(rate)
+	}
+	l.last = time.Now()
+}
+
+// reserve takes n bytes from the bucket and returns the time to wait for them
+func (l *rateLimiter) reserve(n int) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+	if l.tokens > float64(l.rate) {
+		l.tokens = float64(l.rate)
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+}
+
+// wait blocks until n bytes are allowed by the limiter
+func (l *rateLimiter) wait(ctx context.Context, n int) error {
+	d := l.reserve(n)
+	if d == 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// bandwidthLimiter holds daemon level rate limiters, total and per registry.
+// They live as long as daemon so that the limits are shared by all pulls.
+type bandwidthLimiter struct {
+	sync.Mutex
+	total      *rateLimiter
+	registries map[string]*rateLimiter
+}
+
+var gBandwidth = &bandwidthLimiter{
+	total:      newRateLimiter(0),
+	registries: make(map[string]*rateLimiter),
+}
+
+// apply updates the limiters with rates in config
+func (b *bandwidthLimiter) apply(config *daemonConfig) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.total.setRate(config.MaxBytesPerSec)
+	for name, l := range b.registries {
+		l.setRate(config.registry(name).MaxBytesPerSec)
+	}
+}
+
+// limiters returns the limiters applied to blobs from registry, a limiter of
+// maxBytesPerSec is added for the request if set.
+func (b *bandwidthLimiter) limiters(registry string, maxBytesPerSec int64) []*rateLimiter {
+	b.Lock()
+	defer b.Unlock()
+
+	l, ok := b.registries[registry]
+	if !ok {
+		l = newRateLimiter(getDaemonConfig().registry(registry).MaxBytesPerSec)
+		b.registries[registry] = l
+	}
+
+	limiters := []*rateLimiter{b.total, l}
+	if maxBytesPerSec > 0 {
+		limiters = append(limiters, newRateLimiter(maxBytesPerSec))
+	}
+	return limiters
+}
+
+// wrap returns a reference whose blobs are read through the limiters, only
+// references to docker registries are limited.
+func (b *bandwidthLimiter) wrap(ref types.ImageReference, maxBytesPerSec int64) types.ImageReference {
+	if ref.Transport().Name() != "docker" || ref.DockerReference() == nil {
+		return ref
+	}
+
+	return &limitedReference{
+		ImageReference: ref,
+		limiters:       b.limiters(reference.Domain(ref.DockerReference()), maxBytesPerSec),
+	}
+}
+
+type limitedReference struct {
+	types.ImageReference
+	limiters []*rateLimiter
+}
+
+func (r *limitedReference) NewImageSource(ctx context.Context, sys *types.SystemContext) (types.ImageSource, error) {
+	src, err := r.ImageReference.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedImageSource{ImageSource: src, limiters: r.limiters}, nil
+}
+
+type limitedImageSource struct {
+	types.ImageSource
+	limiters []*rateLimiter
+}
+
+func (s *limitedImageSource) GetBlob(ctx context.Context, info types.BlobInfo, cache types.BlobInfoCache) (io.ReadCloser, int64, error) {
+	rc, size, err := s.ImageSource.GetBlob(ctx, info, cache)
+	if err != nil {
+		return nil, 0, err
+	}
+	return &limitedReader{ReadCloser: rc, ctx: ctx, limiters: s.limiters}, size, nil
+}
+
+type limitedReader struct {
+	io.ReadCloser
+	ctx      context.Context
+	limiters []*rateLimiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > limitedReadSize {
+		p = p[:limitedReadSize]
+	}
+	n, err := r.ReadCloser.Read(p)
+	for _, l := range r.limiters {
+		if werr := l.wait(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-18
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := newRateLimiter(0)
+	if d := l.reserve(1 << 30); d != 0 {
+		t.Errorf("expect no wait without limit, got %v", d)
+	}
+
+	l.setRate(1000)
+	if d := l.reserve(2000); d < 1900*time.Millisecond || d > 2*time.Second {
+		t.Errorf("expect about 2s to read 2000 bytes at 1000B/s, got %v", d)
+	}
+
+	l.setRate(0)
+	if d := l.reserve(2000); d != 0 {
+		t.Errorf("expect no wait after limit removed, got %v", d)
+	}
+}
+
+func TestDaemonConfigRegistry(t *testing.T) {
+	c := &daemonConfig{Registries: map[string]registryConfig{"docker.io": {MaxBytesPerSec: 100}}}
+	if c.registry("index.docker.io").MaxBytesPerSec != 100 {
+		t.Errorf("expect index.docker.io to use settings of docker.io")
+	}
+	if c.registry("quay.io").MaxBytesPerSec != 0 {
+		t.Errorf("expect no limit for registry not configured")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-12
+
+package main
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/containers/image/docker/reference"
+	"github.com/sirupsen/logrus"
+)
+
+// pullCall is a pull in progress, all callers pulling the same image share it
+type pullCall struct {
+	done   chan struct{}
+	result *pullResult
+	err    error
+}
+
+func (c *pullCall) wait(ctx context.Context) (*pullResult, error) {
+	select {
+	case <-c.done:
+		return c.result, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// pullManager coalesces concurrent pulls of the same image and limits the
+// number of distinct pulls running at the same time. Pulls exceeding the limit
+// are queued and started in FIFO order. Each pull is registered in tracker
+// until it finishes, so shutdown waits for it even if all callers gave up.
+type pullManager struct {
+	sync.Mutex
+	calls         map[string]*pullCall
+	maxConcurrent int
+	running       int
+	queue         *list.List
+	tracker       *requestTracker
+}
+
+func newPullManager(maxConcurrent int, tracker *requestTracker) *pullManager {
+	return &pullManager{
+		calls:         make(map[string]*pullCall),
+		maxConcurrent: maxConcurrent,
+		queue:         list.New(),
+		tracker:       tracker,
+	}
+}
+
+// normalizePullName returns the normalized reference of an image name, image
+// names referring to the same reference or digest get the same name.
+func normalizePullName(image string) string {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return image
+	}
+
+	return reference.TagNameOnly(named).String()
+}
+
+// pullKey returns the key to coalesce pulls. Pulls share a key only if the
+// image names resolve to the same references in the search registries and
+// they are pulled with the same credentials and limits, so no caller gets an
+// image pulled with the credentials of another one.
+func pullKey(resolve func(string) ([]parsedImageNames, error), image string, popts *pullOptions) string {
+	names := []string{normalizePullName(image)}
+	if images, err := resolve(image); err == nil && len(images) > 0 {
+		names = names[:0]
+		for _, img := range images {
+			names = append(names, normalizePullName(img.name))
+		}
+	}
+	auth := sha256.Sum256([]byte(strings.Join([]string{popts.username, popts.password,
+		popts.identityToken, popts.registryToken}, "\x00")))
+
+	return fmt.Sprintf("%s auth=%x bps=%d parallel=%d", strings.Join(names, ","),
+		auth[:8], popts.maxBytesPerSec, popts.maxParallelDownloads)
+}
+
+func (m *pullManager) acquire() {
+	m.Lock()
+	if m.maxConcurrent <= 0 || (m.running < m.maxConcurrent && m.queue.Len() == 0) {
+		m.running++
+		m.Unlock()
+		return
+	}
+	ch := make(chan struct{})
+	m.queue.PushBack(ch)
+	m.Unlock()
+
+	<-ch
+}
+
+func (m *pullManager) release() {
+	m.Lock()
+	defer m.Unlock()
+
+	// Hand over the slot to the first waiter directly
+	if front := m.queue.Front(); front != nil {
+		m.queue.Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+	m.running--
+}
+
+// pull runs fn once for all concurrent callers with the same key. fn is not
+// bound to ctx of any caller, a caller gives up waiting if its ctx is done
+// while the pull continues for the others.
+func (m *pullManager) pull(ctx context.Context, key string, fn func() (*pullResult, error)) (*pullResult, error) {
+	m.Lock()
+	if call, ok := m.calls[key]; ok {
+		m.Unlock()
+		logrus.Debugf("Pull of %s already in progress, wait for it", key)
+		return call.wait(ctx)
+	}
+	if err := m.tracker.enter(); err != nil {
+		m.Unlock()
+		return nil, err
+	}
+	call := &pullCall{done: make(chan struct{})}
+	m.calls[key] = call
+	m.Unlock()
+
+	go func() {
+		defer m.tracker.leave()
+		m.acquire()
+		call.result, call.err = fn()
+		m.release()
+
+		m.Lock()
+		delete(m.calls, key)
+		m.Unlock()
+		close(call.done)
+	}()
+
+	return call.wait(ctx)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-12
+
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPullKey(t *testing.T) {
+	svc := &imageService{registries: []string{"docker.io"}}
+	anonymous := &pullOptions{}
+	if pullKey(svc.ParseImageNames, "busybox", anonymous) != pullKey(svc.ParseImageNames, "docker.io/library/busybox:latest", anonymous) {
+		t.Errorf("busybox and docker.io/library/busybox:latest should have the same key")
+	}
+	if pullKey(svc.ParseImageNames, "busybox:1.0", anonymous) == pullKey(svc.ParseImageNames, "busybox:latest", anonymous) {
+		t.Errorf("different tags should have different keys")
+	}
+
+	mirror := &imageService{registries: []string{"mirror.example.com"}}
+	if pullKey(svc.ParseImageNames, "busybox", anonymous) == pullKey(mirror.ParseImageNames, "busybox", anonymous) {
+		t.Errorf("busybox resolved to different registries should have different keys")
+	}
+
+	user := &pullOptions{username: "user", password: "secret"}
+	other := &pullOptions{username: "user", password: "other"}
+	if pullKey(svc.ParseImageNames, "busybox", user) == pullKey(svc.ParseImageNames, "busybox", other) {
+		t.Errorf("pulls with different credentials should have different keys")
+	}
+	if pullKey(svc.ParseImageNames, "busybox", user) == pullKey(svc.ParseImageNames, "busybox", anonymous) {
+		t.Errorf("pulls with and without credentials should have different keys")
+	}
+	if strings.Contains(pullKey(svc.ParseImageNames, "busybox", user), "secret") {
+		t.Errorf("key should not contain the password")
+	}
+	token := &pullOptions{identityToken: "token"}
+	otherToken := &pullOptions{identityToken: "other"}
+	if pullKey(svc.ParseImageNames, "busybox", token) == pullKey(svc.ParseImageNames, "busybox", otherToken) {
+		t.Errorf("pulls with different identity tokens should have different keys")
+	}
+	if pullKey(svc.ParseImageNames, "busybox", &pullOptions{registryToken: "token"}) == pullKey(svc.ParseImageNames, "busybox", token) {
+		t.Errorf("pulls with registry token and identity token should have different keys")
+	}
+	limited := &pullOptions{maxBytesPerSec: 1024}
+	if pullKey(svc.ParseImageNames, "busybox", limited) == pullKey(svc.ParseImageNames, "busybox", anonymous) {
+		t.Errorf("pulls with different limits should have different keys")
+	}
+}
+
+func TestPullManagerCoalesce(t *testing.T) {
+	var calls int32
+	m := newPullManager(0, &requestTracker{})
+	start := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := m.pull(context.Background(), "busybox", func() (*pullResult, error) {
+				atomic.AddInt32(&calls, 1)
+				<-start
+				return &pullResult{imageRef: "id"}, nil
+			})
+			if err != nil || result.imageRef != "id" {
+				t.Errorf("pull got %v, %v", result, err)
+			}
+		}()
+	}
+	// Wait until all callers joined the running pull
+	time.Sleep(100 * time.Millisecond)
+	close(start)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expect 1 pull, got %v", calls)
+	}
+}
+
+func TestPullManagerLimit(t *testing.T) {
+	var running, maxRunning int32
+	m := newPullManager(2, &requestTracker{})
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			m.pull(context.Background(), key, func() (*pullResult, error) {
+				cur := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&maxRunning)
+					if cur <= old || atomic.CompareAndSwapInt32(&maxRunning, old, cur) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				return &pullResult{imageRef: key}, nil
+			})
+		}(key)
+	}
+	wg.Wait()
+
+	if maxRunning > 2 {
+		t.Errorf("expect at most 2 pulls running, got %v", maxRunning)
+	}
+}
+
+func TestPullManagerTracked(t *testing.T) {
+	tracker := &requestTracker{}
+	m := newPullManager(0, tracker)
+	start := make(chan struct{})
+	finished := make(chan struct{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		m.pull(ctx, "busybox", func() (*pullResult, error) {
+			<-start
+			close(finished)
+			return &pullResult{imageRef: "id"}, nil
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+	// The caller gives up, but the pull still writes to the store
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	if tracker.drain(50 * time.Millisecond) {
+		t.Fatalf("drain should wait for the running pull")
+	}
+
+	close(start)
+	if !tracker.drain(time.Second) {
+		t.Fatalf("drain should finish after the pull finished")
+	}
+	<-finished
+	if _, err := m.pull(context.Background(), "busybox", nil); err != ErrDaemonShuttingDown {
+		t.Errorf("pull after drain got %v, want %v", err, ErrDaemonShuttingDown)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/containers/image/docker/tarfile"
+	"github.com/containers/image/image"
+	"github.com/containers/image/pkg/blobinfocache"
+	"github.com/containers/image/transports/alltransports"
+	"github.com/containers/image/types"
+	"github.com/sirupsen/logrus"
+)
+
+// repairSource is a place the layers of an image can be fetched again
+type repairSource struct {
+	ref types.ImageReference
+	sys *types.SystemContext
+}
+
+func (s repairSource) String() string {
+	return s.ref.Transport().Name() + ":" + s.ref.StringWithinTransport()
+}
+
+// archiveRepairSources returns sources of all images in a load archive, the
+// returned function must be called to release the archive.
+func archiveRepairSources(archive string) ([]repairSource, func(), error) {
+	tar, err := tarfile.NewSourceFromFile(archive, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var names []string
+	tags, err := getTagFromArchive(tar)
+	if err != nil {
+		// Archive of a single image may have no tag
+		names = append(names, "docker-archive:"+tarfile.TarPath(tar))
+	}
+	for _, tag := range tags {
+		names = append(names, "docker-archive:"+tarfile.TarPath(tar)+":"+tag)
+	}
+
+	var sources []repairSource
+	for _, name := range names {
+		ref, err := alltransports.ParseImageName(name)
+		if err != nil {
+			tar.Close()
+			return nil, nil, fmt.Errorf("Invalid input name %s: %v", name, err)
+		}
+		sources = append(sources, repairSource{ref: ref, sys: &types.SystemContext{}})
+	}
+
+	return sources, func() { tar.Close() }, nil
+}
+
+// registryRepairSources returns sources of the image in registries by its RepoDigests
+func registryRepairSources(gopts *globalOptions, svc ImageServer, imageID string) ([]repairSource, error) {
+	spec, err := svc.GetOneImage(&types.SystemContext{}, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []repairSource
+	for _, repoDigest := range spec.RepoDigests {
+		ref, err := alltransports.ParseImageName("docker://" + repoDigest)
+		if err != nil {
+			logrus.Warnf("Invalid repo digest %s of image %s: %v", repoDigest, imageID, err)
+			continue
+		}
+		domain, _ := parseDockerDomain(repoDigest)
+		sys := &types.SystemContext{
+			DockerInsecureSkipTLSVerify: types.NewOptionalBool(!svc.IsSecureIndex(domain)),
+			AuthFilePath:                defaultAuthFilePath(),
+			DockerTransportHook:         configureTransport,
+		}
+		setupRegistryCerts(gopts, sys)
+		if err := setupCredentials(sys, domain); err != nil {
+			logrus.Warnf("Failed to get credentials of %s: %v", domain, err)
+			continue
+		}
+		sources = append(sources, repairSource{ref: gBandwidth.wrap(ref, 0), sys: sys})
+	}
+
+	return sources, nil
+}
+
+// repairFromSource repairs the failed layers in result with blobs from source,
+// layers repaired are marked in result.
+func (svc *imageService) repairFromSource(result *imageVerifyResult, source repairSource) error {
+	src, err := source.ref.NewImageSource(svc.ctx, source.sys)
+	if err != nil {
+		return err
+	}
+	img, err := image.FromSource(svc.ctx, source.sys, src)
+	if err != nil {
+		src.Close()
+		return err
+	}
+	defer img.Close()
+
+	config, err := img.OCIConfig(svc.ctx)
+	if err != nil {
+		return err
+	}
+	blobs := img.LayerInfos()
+	diffIDs := config.RootFS.DiffIDs
+	if len(diffIDs) != len(result.Layers) || len(blobs) != len(diffIDs) {
+		return fmt.Errorf("layers of %s do not match the image", source)
+	}
+	for i := range result.Layers {
+		if diffIDs[i].String() != result.Layers[i].DiffID {
+			return fmt.Errorf("diffIDs of %s do not match the image", source)
+		}
+	}
+
+	// Repair from the base layer, drivers may copy contents of the parent
+	for i := range result.Layers {
+		layer := &result.Layers[i]
+		if layer.OK || layer.Repaired {
+			continue
+		}
+		rc, _, err := src.GetBlob(svc.ctx, blobs[i], blobinfocache.NoCache)
+		if err != nil {
+			return fmt.Errorf("failed to fetch layer %s from %s: %v", blobs[i].Digest, source, err)
+		}
+		_, err = svc.store.RepairLayer(layer.ID, rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("failed to repair layer %s: %v", layer.ID, err)
+		}
+		repaired, err := svc.store.Layer(layer.ID)
+		if err != nil {
+			return err
+		}
+		if repaired.UncompressedDigest.String() != layer.DiffID {
+			return fmt.Errorf("layer %s from %s has diffID %s, expected %s", blobs[i].Digest, source, repaired.UncompressedDigest, layer.DiffID)
+		}
+		logrus.Infof("Layer %s of image %s repaired from %s", layer.ID, result.ID, source)
+		layer.Repaired = true
+	}
+
+	return nil
+}
+
+// RepairImage re-fetches the failed layers in result from sources and extracts
+// them again under the same layer IDs. Sources are tried in order until all
+// failed layers are repaired.
+func (svc *imageService) RepairImage(result *imageVerifyResult, sources []repairSource) error {
+	if result.Error != "" {
+		return fmt.Errorf("image %s can not be repaired: %s", result.ID, result.Error)
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no source to repair image %s", result.ID)
+	}
+
+	var err error
+	for _, source := range sources {
+		if err = svc.repairFromSource(result, source); err == nil {
+			return nil
+		}
+		logrus.Warnf("Failed to repair image %s from %s: %v", result.ID, source, err)
+	}
+
+	return err
+}
@@ -0,0 +1,168 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-17
+
+package main
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containers/image/docker"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBackoff     = time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+	retryBackoffMultiplier  = 2
+	// backoff is randomized in [backoff*(1-jitter), backoff*(1+jitter)]
+	retryJitter = 0.2
+)
+
+// retryPolicy decides how many times and how long to wait before retrying
+// a failed request to registry.
+type retryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		Backoff:     defaultRetryBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// backoff returns the time to wait before next attempt, attempt starts from 1
+func (p retryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= retryBackoffMultiplier
+	}
+	d = time.Duration(float64(d) * (1 + retryJitter*(2*rand.Float64()-1)))
+
+	// Registry knows better than us, but never wait longer than MaxBackoff
+	if retryAfter > d {
+		d = retryAfter
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	return d
+}
+
+// unwrapError returns the error wrapped by err, nil if nothing wrapped
+func unwrapError(err error) error {
+	switch e := err.(type) {
+	case *url.Error:
+		return e.Err
+	case *net.OpError:
+		return e.Err
+	case *os.SyscallError:
+		return e.Err
+	}
+
+	cause := errors.Cause(err)
+	if cause == err {
+		return nil
+	}
+	return cause
+}
+
+// isRetryableError classifies errors returned by registry operations, and
+// returns the time registry asked us to wait if any.
+func isRetryableError(err error) (bool, time.Duration) {
+	for ; err != nil; err = unwrapError(err) {
+		switch e := err.(type) {
+		case docker.ErrUnexpectedHTTPStatus:
+			switch e.StatusCode {
+			case http.StatusTooManyRequests, http.StatusRequestTimeout, http.StatusInternalServerError,
+				http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+				return true, e.RetryAfter
+			}
+			return false, 0
+		case syscall.Errno:
+			return e == syscall.ECONNRESET || e == syscall.ECONNREFUSED || e == syscall.ECONNABORTED ||
+				e == syscall.EPIPE || e == syscall.ETIMEDOUT || e == syscall.EHOSTUNREACH || e == syscall.ENETUNREACH, 0
+		case net.Error:
+			if e.Timeout() {
+				return true, 0
+			}
+		}
+
+		if err == context.Canceled || err == docker.ErrUnauthorizedForCredentials {
+			return false, 0
+		}
+		// A cleanly closed response is not transient, only a truncated one is
+		if err == io.ErrUnexpectedEOF {
+			return true, 0
+		}
+	}
+
+	return false, 0
+}
+
+// isRetryableErrorMessage checks error message for transient network errors
+// whose type is lost by fmt.Errorf in the call chain.
+func isRetryableErrorMessage(err error) bool {
+	msg := err.Error()
+	for _, s := range []string{"connection reset by peer", "unexpected EOF", "i/o timeout",
+		"TLS handshake timeout", "connection refused", "broken pipe"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// retry calls fn until it succeeds, returns a fatal error or policy gives up,
+// returns the number of attempts made.
+func retry(ctx context.Context, policy retryPolicy, desc string, fn func() error) (int, error) {
+	attempts := 0
+	for {
+		attempts++
+		err := fn()
+		if err == nil {
+			return attempts, nil
+		}
+
+		retryable, retryAfter := isRetryableError(err)
+		if !retryable && retryAfter == 0 {
+			retryable = isRetryableErrorMessage(err)
+		}
+		if !retryable || attempts >= policy.MaxAttempts {
+			return attempts, err
+		}
+
+		wait := policy.backoff(attempts, retryAfter)
+		logrus.Warnf("%s failed on attempt %d, retry in %v: %v", desc, attempts, wait, err)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return attempts, err
+		}
+	}
+}
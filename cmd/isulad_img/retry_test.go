@@ -0,0 +1,97 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-17
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/containers/image/docker"
+	perrors "github.com/pkg/errors"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{perrors.Wrap(docker.ErrUnexpectedHTTPStatus{StatusCode: 503, Err: errors.New("503")}, "pull"), true},
+		{perrors.Wrap(docker.ErrUnexpectedHTTPStatus{StatusCode: 429, Err: errors.New("429")}, "pull"), true},
+		{perrors.Wrap(docker.ErrUnexpectedHTTPStatus{StatusCode: 404, Err: errors.New("404")}, "pull"), false},
+		{&net.OpError{Op: "read", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}, true},
+		{docker.ErrUnauthorizedForCredentials, false},
+		{perrors.Wrap(io.ErrUnexpectedEOF, "read blob"), true},
+		{perrors.Wrap(io.EOF, "read manifest"), false},
+		{errors.New("manifest unknown"), false},
+	}
+
+	for _, c := range cases {
+		if retryable, _ := isRetryableError(c.err); retryable != c.retryable {
+			t.Errorf("expect retryable %v for %v, got %v", c.retryable, c.err, retryable)
+		}
+	}
+
+	_, retryAfter := isRetryableError(docker.ErrUnexpectedHTTPStatus{StatusCode: 429, RetryAfter: time.Minute, Err: errors.New("429")})
+	if retryAfter != time.Minute {
+		t.Errorf("expect retry after 1m, got %v", retryAfter)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := retryPolicy{MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 4 * time.Second}
+
+	if d := p.backoff(1, 0); d < 800*time.Millisecond || d > 1200*time.Millisecond {
+		t.Errorf("unexpected backoff of first attempt %v", d)
+	}
+	for i := 0; i < 100; i++ {
+		if d := p.backoff(10, 0); d > 4*time.Second {
+			t.Fatalf("backoff %v exceeds max backoff", d)
+		}
+	}
+	if d := p.backoff(1, 3*time.Second); d != 3*time.Second {
+		t.Errorf("expect Retry-After respected, got %v", d)
+	}
+	if d := p.backoff(1, time.Hour); d != 4*time.Second {
+		t.Errorf("expect Retry-After clamped to max backoff, got %v", d)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	p := retryPolicy{MaxAttempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	calls := 0
+	attempts, err := retry(context.Background(), p, "test", func() error {
+		calls++
+		if calls < 2 {
+			return docker.ErrUnexpectedHTTPStatus{StatusCode: 502, Err: errors.New("502")}
+		}
+		return nil
+	})
+	if err != nil || attempts != 2 {
+		t.Errorf("expect success on attempt 2, got %v, %v", attempts, err)
+	}
+
+	attempts, err = retry(context.Background(), p, "test", func() error {
+		return errors.New("manifest unknown")
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("expect fatal error on attempt 1, got %v, %v", attempts, err)
+	}
+}
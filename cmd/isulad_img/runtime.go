@@ -41,6 +41,7 @@ import (
 	"github.com/containers/image/types"
 	"github.com/containers/storage"
 	constorage "github.com/containers/storage"
+	"github.com/containers/storage/pkg/idtools"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -69,6 +70,41 @@ type ContainerSpec struct {
 	RunDir     string
 	MountPoint string
 	LayerID    string
+	// UIDMap and GIDMap are ID mappings of the rootfs, empty if host IDs are used
+	UIDMap []idtools.IDMap
+	GIDMap []idtools.IDMap
+	// MountLabel is the SELinux context of the rootfs and ProcessLabel is
+	// the label for processes of the container, empty if SELinux is disabled
+	MountLabel   string
+	ProcessLabel string
+}
+
+// ContainerCreateOptions are optional settings of a container to create
+type ContainerCreateOptions struct {
+	// UIDMap and GIDMap map IDs in the user namespace of the container to
+	// host IDs, files in the rootfs are owned by the mapped IDs
+	UIDMap []idtools.IDMap
+	GIDMap []idtools.IDMap
+	// AutoUserns allocates ID mappings of AutoUsernsSize IDs, 65536 if 0,
+	// from subordinate IDs not used by other containers
+	AutoUserns     bool
+	AutoUsernsSize uint32
+	// MountLabel is the SELinux label mounting the rootfs with. A label with
+	// unique MCS level is generated if it is not set and SELinux is enabled,
+	// LabelOpts like "type:" and "level:" change parts of the generated label.
+	MountLabel string
+	LabelOpts  []string
+	// Pod is the pod the container belongs to, nil if it does not belong to a pod
+	Pod *PodInfo
+}
+
+// PodInfo is the pod a container belongs to, like PodSandboxMetadata of CRI
+type PodInfo struct {
+	ID        string
+	Name      string
+	Namespace string
+	UID       string
+	Attempt   uint32
 }
 
 // ContainerServer display all related operations
@@ -76,16 +112,20 @@ type ContainerServer interface {
 	// GetContainer
 	GetContainerLayerID(id string) (string, error)
 	// CreateContainer
-	CreateContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string) (ContainerSpec, error)
+	CreateContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string, options *ContainerCreateOptions) (ContainerSpec, error)
 	// RemoveContainer
 	RemoveContainer(containerID string) error
 	// UmountContainer
 	UmountContainer(containerID string, force bool) error
 }
 
-func (r *containerLifeService) createContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string) (ContainerSpec, error) {
+func (r *containerLifeService) createContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string, options *ContainerCreateOptions) (ContainerSpec, error) {
 	var ref types.ImageReference
 
+	if options == nil {
+		options = &ContainerCreateOptions{}
+	}
+
 	if imageName == "" && imageID == "" {
 		return ContainerSpec{}, ErrImageName
 	}
@@ -130,6 +170,16 @@ func (r *containerLifeService) createContainer(systemContext *types.SystemContex
 	}
 	imageID = img.ID
 
+	processLabel, mountLabel, generated, err := containerLabels(options.MountLabel, options.LabelOpts)
+	if err != nil {
+		return ContainerSpec{}, err
+	}
+	defer func() {
+		if err != nil && generated {
+			gReleaseLabel(processLabel)
+		}
+	}()
+
 	metadata := constorage.RuntimeContainerMetadata{
 		Pod:           false,
 		PodName:       containerName,
@@ -142,21 +192,31 @@ func (r *containerLifeService) createContainer(systemContext *types.SystemContex
 		Namespace:     "",
 		Attempt:       0,
 		CreatedAt:     time.Now().Unix(),
-		MountLabel:    "",
+		MountLabel:    mountLabel,
+	}
+	if pod := options.Pod; pod != nil {
+		metadata.Pod = true
+		metadata.PodID = pod.ID
+		metadata.PodName = pod.Name
+		metadata.UID = pod.UID
+		metadata.Namespace = pod.Namespace
+		metadata.Attempt = pod.Attempt
 	}
 	mdata, err := json.Marshal(&metadata)
 	if err != nil {
 		return ContainerSpec{}, err
 	}
 
+	// Containers of a pod share the pod name, so it is not one of their names
 	names := []string{metadata.ContainerName}
-	if metadata.Pod {
-		names = append(names, metadata.PodName)
-	}
 
 	coptions := &constorage.ContainerOptions{}
 
 	coptions.Flags = make(map[string]interface{})
+	if processLabel != "" {
+		coptions.Flags["ProcessLabel"] = processLabel
+		coptions.Flags["MountLabel"] = mountLabel
+	}
 	if storageOpts != nil {
 		tmpStorageOpts := make(map[string]string)
 		for k, v := range storageOpts {
@@ -165,6 +225,29 @@ func (r *containerLifeService) createContainer(systemContext *types.SystemContex
 		coptions.Flags["StorageOpts"] = tmpStorageOpts
 	}
 
+	if options.AutoUserns {
+		size := int(options.AutoUsernsSize)
+		if size == 0 {
+			size = defaultAutoUsernsSize
+		}
+		user := getDaemonConfig().AutoUsernsUser
+		if user == "" {
+			user = defaultAutoUsernsUser
+		}
+		// IDs allocated are taken once the container is created
+		gUsernsLock.Lock()
+		defer gUsernsLock.Unlock()
+		if coptions.UIDMap, coptions.GIDMap, err = autoIDMappings(r.imageServer.GetStore(), user, size); err != nil {
+			return ContainerSpec{}, err
+		}
+	} else {
+		if err = validateIDMaps(options.UIDMap, options.GIDMap); err != nil {
+			return ContainerSpec{}, err
+		}
+		coptions.UIDMap = options.UIDMap
+		coptions.GIDMap = options.GIDMap
+	}
+
 	container, err := r.imageServer.GetStore().CreateContainer(containerID, names, img.ID, "", string(mdata), coptions)
 	if err != nil {
 		return ContainerSpec{}, err
@@ -206,11 +289,16 @@ func (r *containerLifeService) createContainer(systemContext *types.SystemContex
 		Config:     imageConfig,
 		MountPoint: container.MountPoint,
 		LayerID:    container.LayerID,
+		UIDMap:     container.UIDMap,
+		GIDMap:     container.GIDMap,
+
+		MountLabel:   container.MountLabel(),
+		ProcessLabel: container.ProcessLabel(),
 	}, nil
 }
 
-func (r *containerLifeService) CreateContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string) (ContainerSpec, error) {
-	return r.createContainer(systemContext, imageName, imageID, containerName, containerID, storageOpts)
+func (r *containerLifeService) CreateContainer(systemContext *types.SystemContext, imageName, imageID, containerName, containerID string, storageOpts map[string]string, options *ContainerCreateOptions) (ContainerSpec, error) {
+	return r.createContainer(systemContext, imageName, imageID, containerName, containerID, storageOpts, options)
 }
 
 func (r *containerLifeService) RemoveContainer(containerID string) error {
@@ -0,0 +1,94 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opencontainers/selinux/go-selinux"
+	"github.com/opencontainers/selinux/go-selinux/label"
+)
+
+// Labels are handled by these functions, so that tests can run without SELinux
+var (
+	gSelinuxEnabled = selinux.GetEnabled
+	gInitLabels     = label.InitLabels
+	gReleaseLabel   = label.ReleaseLabel
+)
+
+// selinuxLabelLevel returns the MCS level of a label like "user:role:type:level"
+func selinuxLabelLevel(l string) (string, error) {
+	con := strings.SplitN(l, ":", 4)
+	if len(con) != 4 {
+		return "", fmt.Errorf("invalid SELinux label %q, it should be user:role:type:level", l)
+	}
+	for _, c := range con {
+		if c == "" {
+			return "", fmt.Errorf("invalid SELinux label %q, it should be user:role:type:level", l)
+		}
+	}
+	return con[3], nil
+}
+
+// validateLabelOpts checks options of generated labels, which are "user:",
+// "role:", "type:" or "level:" followed by the value
+func validateLabelOpts(opts []string) error {
+	for _, opt := range opts {
+		con := strings.SplitN(opt, ":", 2)
+		if len(con) != 2 || con[1] == "" {
+			return fmt.Errorf("invalid label option %q, it should be user, role, type or level followed by ':' and a value", opt)
+		}
+		switch con[0] {
+		case "user", "role", "type", "level":
+		default:
+			return fmt.Errorf("invalid label option %q, it should be user, role, type or level followed by ':' and a value", opt)
+		}
+	}
+	return nil
+}
+
+// containerLabels returns the process label and mount label of a container.
+// The mount label is generated with a unique MCS level if mountLabel is not
+// set, and the process label runs at the same level as the mount label.
+// Both are empty if SELinux is disabled. The MCS level is reserved until the
+// layer mounted with the label is deleted. The flag returned is true if the
+// level is generated for this container. The level of mountLabel belongs to
+// the caller and may be shared with other containers, it is not released if
+// creating the container fails.
+func containerLabels(mountLabel string, opts []string) (string, string, bool, error) {
+	if mountLabel == "" {
+		if err := validateLabelOpts(opts); err != nil {
+			return "", "", false, err
+		}
+		processLabel, mountLabel, err := gInitLabels(opts)
+		if err != nil {
+			return "", "", false, err
+		}
+		return processLabel, mountLabel, processLabel != "", nil
+	}
+
+	if !gSelinuxEnabled() {
+		return "", "", false, fmt.Errorf("can not set mount label %s, SELinux is disabled", mountLabel)
+	}
+	level, err := selinuxLabelLevel(mountLabel)
+	if err != nil {
+		return "", "", false, err
+	}
+	processLabel, _, err := gInitLabels([]string{"level:" + level})
+	if err != nil {
+		return "", "", false, err
+	}
+	return processLabel, mountLabel, false, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/containers/storage/pkg/idtools"
+)
+
+func TestSelinuxLabelLevel(t *testing.T) {
+	level, err := selinuxLabelLevel("system_u:object_r:container_file_t:s0:c1,c2")
+	if err != nil || level != "s0:c1,c2" {
+		t.Errorf("got level %q, %v, want s0:c1,c2", level, err)
+	}
+	for _, l := range []string{"", "system_u:object_r:container_file_t", "system_u::container_file_t:s0"} {
+		if _, err := selinuxLabelLevel(l); err == nil {
+			t.Errorf("label %q should be invalid", l)
+		}
+	}
+}
+
+func TestValidateLabelOpts(t *testing.T) {
+	if err := validateLabelOpts([]string{"type:container_t", "level:s0:c1,c2"}); err != nil {
+		t.Errorf("valid options rejected: %v", err)
+	}
+	for _, opt := range []string{"disable", "type", "level:", "range:s0"} {
+		if err := validateLabelOpts([]string{opt}); err == nil {
+			t.Errorf("option %q should be invalid", opt)
+		}
+	}
+}
+
+// useFakeSelinux makes labels as if SELinux is enabled, the process labels
+// released are recorded in released
+func useFakeSelinux(released *[]string) func() {
+	oldEnabled, oldInitLabels, oldReleaseLabel := gSelinuxEnabled, gInitLabels, gReleaseLabel
+	gSelinuxEnabled = func() bool { return true }
+	gInitLabels = func(opts []string) (string, string, error) {
+		level := "s0:c1,c2"
+		for _, opt := range opts {
+			if strings.HasPrefix(opt, "level:") {
+				level = strings.TrimPrefix(opt, "level:")
+			}
+		}
+		return "system_u:system_r:container_t:" + level, "system_u:object_r:container_file_t:" + level, nil
+	}
+	gReleaseLabel = func(l string) error {
+		*released = append(*released, l)
+		return nil
+	}
+	return func() {
+		gSelinuxEnabled, gInitLabels, gReleaseLabel = oldEnabled, oldInitLabels, oldReleaseLabel
+	}
+}
+
+func TestCreateContainerReleaseLabel(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+	image := createTestImage(t, svc.store, "label", "a")
+	var released []string
+	defer useFakeSelinux(&released)()
+	server := GetContainerLifeService(context.Background(), svc, "")
+
+	// An invalid ID mapping fails creating the container after labels are set
+	invalidMap := []idtools.IDMap{{ContainerID: 0, HostID: 0, Size: 0}}
+	cases := []struct {
+		name       string
+		mountLabel string
+		released   []string
+	}{
+		{"generated", "", []string{"system_u:system_r:container_t:s0:c1,c2"}},
+		{"supplied", "system_u:object_r:container_file_t:s0:c3,c4", nil},
+	}
+	for _, c := range cases {
+		released = nil
+		_, err := server.CreateContainer(nil, "", image.ID, c.name, "", nil, &ContainerCreateOptions{
+			MountLabel: c.mountLabel,
+			UIDMap:     invalidMap,
+			GIDMap:     invalidMap,
+		})
+		if err == nil || !strings.Contains(err.Error(), "invalid ID mapping") {
+			t.Fatalf("%s: got %v, want error of invalid ID mapping", c.name, err)
+		}
+		if strings.Join(released, ",") != strings.Join(c.released, ",") {
+			t.Errorf("%s: released labels %v, want %v", c.name, released, c.released)
+		}
+	}
+}
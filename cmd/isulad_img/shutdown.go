@@ -0,0 +1,192 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-10
+
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	cstorage "github.com/containers/storage"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultShutdownTimeout = 30 * time.Second
+	// time to wait for aborted requests to clean up after the drain deadline expired
+	abortTimeout = 10 * time.Second
+	// flag set by containers/storage on layers whose diff is not fully applied
+	incompleteLayerFlag = "incomplete"
+)
+
+// ErrDaemonShuttingDown new work is rejected because daemon is shutting down
+var ErrDaemonShuttingDown = errors.New("daemon is shutting down")
+
+// daemonCtx is used by long running operations like pull and load, it is
+// cancelled if they do not finish before the shutdown deadline.
+var daemonCtx, cancelDaemonCtx = context.WithCancel(context.Background())
+
+// backgroundCtx is cancelled as soon as shutdown starts, background tasks like
+// eviction and deep check of layers stop at it instead of waiting for the deadline.
+var backgroundCtx, cancelBackgroundCtx = context.WithCancel(context.Background())
+
+// gTracker tracks running requests and background tasks of daemon
+var gTracker = &requestTracker{}
+
+// requestTracker counts long running requests (pull, load, import and export),
+// so that shutdown can wait for them.
+type requestTracker struct {
+	sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+// enter registers a new request, it fails if daemon is shutting down.
+func (t *requestTracker) enter() error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.draining {
+		return ErrDaemonShuttingDown
+	}
+	t.wg.Add(1)
+
+	return nil
+}
+
+func (t *requestTracker) leave() {
+	t.wg.Done()
+}
+
+// drain rejects new requests and waits at most timeout for running ones,
+// returns false if timeout expired.
+func (t *requestTracker) drain(timeout time.Duration) bool {
+	t.Lock()
+	t.draining = true
+	t.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// goBackground runs fn in a goroutine tracked by gTracker, so that shutdown
+// waits for it. fn should return soon after backgroundCtx is done. It returns
+// false if fn is not started because daemon is shutting down.
+func goBackground(name string, fn func()) bool {
+	if err := gTracker.enter(); err != nil {
+		logrus.Warnf("Not starting %s: %v", name, err)
+		return false
+	}
+	go func() {
+		defer gTracker.leave()
+		fn()
+	}()
+	return true
+}
+
+// drainRequests waits at most timeout for running requests, then calls abort
+// and waits for them again, returns false if they are still running.
+func drainRequests(tracker *requestTracker, timeout time.Duration, abort func()) bool {
+	if tracker.drain(timeout) {
+		return true
+	}
+
+	logrus.Warnf("Running requests not finished in %v, abort them", timeout)
+	abort()
+	if !tracker.drain(abortTimeout) {
+		logrus.Errorf("Aborted requests not finished in %v", abortTimeout)
+		return false
+	}
+	return true
+}
+
+// shutdownStore rolls back incomplete layers and shuts down the store. Layers
+// are rolled back only if all requests are finished, as running ones may still
+// be writing them. Layers mounted by running containers are kept mounted.
+func shutdownStore(store cstorage.Store, drained bool) {
+	if drained {
+		rollbackIncompleteLayers(store)
+	} else {
+		logrus.Warnf("Requests still running, skip rollback of incomplete layers")
+	}
+
+	layers, err := store.Shutdown(false)
+	if err != nil {
+		logrus.Warnf("Store shut down with mounted layers %v: %v", layers, err)
+	}
+}
+
+// rollbackIncompleteLayers deletes layers left half applied by aborted pulls or loads
+func rollbackIncompleteLayers(store cstorage.Store) {
+	layers, err := store.Layers()
+	if err != nil {
+		logrus.Errorf("Failed to get layers for rollback: %v", err)
+		return
+	}
+
+	for _, layer := range layers {
+		if incomplete, ok := layer.Flags[incompleteLayerFlag].(bool); !ok || !incomplete {
+			continue
+		}
+		logrus.Warnf("Rollback incomplete layer %s", layer.ID)
+		if err := store.DeleteLayer(layer.ID); err != nil {
+			logrus.Errorf("Failed to delete incomplete layer %s: %v", layer.ID, err)
+		}
+	}
+}
+
+// gracefulShutdown stops accepting new requests, waits for running ones until
+// timeout, then cleans up the store and the info file.
+func (s *grpcImageService) gracefulShutdown(server *grpc.Server) {
+	timeout := s.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	cancelBackgroundCtx()
+	drained := drainRequests(gTracker, timeout, cancelDaemonCtx)
+
+	select {
+	case <-stopped:
+	case <-time.After(abortTimeout):
+		server.Stop()
+	}
+
+	store, err := getStorageStore(s.gopts)
+	if err != nil {
+		logrus.Errorf("Failed to get store when shutdown: %v", err)
+	} else {
+		shutdownStore(store, drained)
+	}
+
+	delInfoFile(defaultInfoFile)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-10
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	cstorage "github.com/containers/storage"
+)
+
+// shutdownStoreFake records what shutdown does to the store
+type shutdownStoreFake struct {
+	cstorage.Store
+	layers  []cstorage.Layer
+	deleted []string
+	force   []bool
+}
+
+func (f *shutdownStoreFake) Layers() ([]cstorage.Layer, error) {
+	return f.layers, nil
+}
+
+func (f *shutdownStoreFake) DeleteLayer(id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *shutdownStoreFake) Shutdown(force bool) ([]string, error) {
+	f.force = append(f.force, force)
+	return []string{"mounted"}, cstorage.ErrLayerUsedByContainer
+}
+
+func TestDrainRequests(t *testing.T) {
+	tracker := &requestTracker{}
+	if !drainRequests(tracker, time.Second, func() { t.Errorf("idle requests should not be aborted") }) {
+		t.Errorf("drain without requests should finish")
+	}
+	if err := tracker.enter(); err != ErrDaemonShuttingDown {
+		t.Errorf("enter after drain got %v, want %v", err, ErrDaemonShuttingDown)
+	}
+
+	// A request finishing once it is aborted
+	tracker = &requestTracker{}
+	if err := tracker.enter(); err != nil {
+		t.Fatalf("enter failed: %v", err)
+	}
+	if tracker.drain(10 * time.Millisecond) {
+		t.Errorf("drain should time out while a request is running")
+	}
+	aborted := false
+	if !drainRequests(tracker, 10*time.Millisecond, func() {
+		aborted = true
+		tracker.leave()
+	}) {
+		t.Errorf("drain should finish after the request is aborted")
+	}
+	if !aborted {
+		t.Errorf("request not finished before timeout should be aborted")
+	}
+}
+
+func TestShutdownStore(t *testing.T) {
+	layers := []cstorage.Layer{
+		{ID: "complete", Flags: map[string]interface{}{}},
+		{ID: "incomplete", Flags: map[string]interface{}{incompleteLayerFlag: true}},
+	}
+
+	store := &shutdownStoreFake{layers: layers}
+	shutdownStore(store, true)
+	if len(store.deleted) != 1 || store.deleted[0] != "incomplete" {
+		t.Errorf("deleted layers %v, want [incomplete]", store.deleted)
+	}
+	if len(store.force) != 1 || store.force[0] {
+		t.Errorf("store should be shut down once without force, got %v", store.force)
+	}
+
+	// Requests still running may be writing the incomplete layer
+	store = &shutdownStoreFake{layers: layers}
+	shutdownStore(store, false)
+	if len(store.deleted) != 0 {
+		t.Errorf("layers %v deleted while requests are running", store.deleted)
+	}
+	if len(store.force) != 1 || store.force[0] {
+		t.Errorf("store should be shut down once without force, got %v", store.force)
+	}
+}
@@ -13,7 +13,21 @@
 
 package main
 
-func storageStatus(gopts *globalOptions) ([][2]string, error) {
+import (
+	graphdriver "github.com/containers/storage/drivers"
+)
+
+// graphdriverStatus is the status of the graph driver in use
+type graphdriverStatus struct {
+	Name              string
+	BackingFilesystem string
+	// Pairs are the status returned by the driver for showing to users
+	Pairs [][2]string
+	// Typed is nil if the driver can not report its status in typed fields
+	Typed *graphdriver.TypedStatus
+}
+
+func storageStatus(gopts *globalOptions) (*graphdriverStatus, error) {
 	store, err := getStorageStore(gopts)
 	if err != nil {
 		return nil, err
@@ -24,5 +38,18 @@ func storageStatus(gopts *globalOptions) ([][2]string, error) {
 		return nil, err
 	}
 
-	return driver.Status(), err
+	status := &graphdriverStatus{
+		Name:  driver.String(),
+		Pairs: driver.Status(),
+	}
+	for _, kv := range status.Pairs {
+		if kv[0] == "Backing Filesystem" {
+			status.BackingFilesystem = kv[1]
+		}
+	}
+	if reporter, ok := graphdriver.Unwrap(driver).(graphdriver.TypedStatusReporter); ok {
+		status.Typed = reporter.TypedStatus()
+	}
+
+	return status, nil
 }
@@ -0,0 +1,205 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-28
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "isula-image/isula"
+
+	cstorage "github.com/containers/storage"
+	graphdriver "github.com/containers/storage/drivers"
+)
+
+// statusDriverFake reports the status it is given
+type statusDriverFake struct {
+	graphdriver.Driver
+	pairs [][2]string
+	typed *graphdriver.TypedStatus
+}
+
+func (d *statusDriverFake) String() string {
+	return "fake"
+}
+
+func (d *statusDriverFake) Status() [][2]string {
+	return d.pairs
+}
+
+func (d *statusDriverFake) TypedStatus() *graphdriver.TypedStatus {
+	return d.typed
+}
+
+// statusStoreFake is a store using driver
+type statusStoreFake struct {
+	cstorage.Store
+	driver graphdriver.Driver
+}
+
+func (s *statusStoreFake) GraphDriver() (graphdriver.Driver, error) {
+	return s.driver, nil
+}
+
+func useStatusStore(store cstorage.Store) func() {
+	oldStore := gStore
+	gStore = store
+	return func() {
+		gStore = oldStore
+	}
+}
+
+func TestTransStatusToPB(t *testing.T) {
+	if transOverlayStatusToPB(nil) != nil || transDevmapperStatusToPB(nil) != nil {
+		t.Errorf("status of drivers not in use should be nil")
+	}
+
+	overlay := transOverlayStatusToPB(&graphdriver.OverlayStatus{
+		BackingFilesystem: "xfs",
+		SupportsDType:     true,
+		ProjectQuota:      true,
+	})
+	if !reflect.DeepEqual(overlay, &pb.OverlayStatus{SupportsDType: true, ProjectQuota: true}) {
+		t.Errorf("got overlay status %+v", overlay)
+	}
+
+	devmapper := transDevmapperStatusToPB(&graphdriver.DevmapperStatus{
+		PoolName:                   "pool",
+		DataFile:                   "/dev/loop0",
+		MetadataFile:               "/dev/loop1",
+		DataLoopback:               "/var/lib/data",
+		MetadataLoopback:           "/var/lib/metadata",
+		DataUsed:                   1,
+		DataTotal:                  2,
+		DataAvailable:              3,
+		MetadataUsed:               4,
+		MetadataTotal:              5,
+		MetadataAvailable:          6,
+		MinFreeSpace:               7,
+		BaseDeviceSize:             8,
+		UdevSyncSupported:          true,
+		DeferredRemoval:            true,
+		DeferredDeletion:           true,
+		DeferredDeletedDeviceCount: 9,
+	})
+	expected := &pb.DevicemapperStatus{
+		PoolName:               "pool",
+		DataFile:               "/dev/loop0",
+		MetadataFile:           "/dev/loop1",
+		DataLoopFile:           "/var/lib/data",
+		MetadataLoopFile:       "/var/lib/metadata",
+		DataUsed:               1,
+		DataTotal:              2,
+		DataAvailable:          3,
+		MetadataUsed:           4,
+		MetadataTotal:          5,
+		MetadataAvailable:      6,
+		MinFreeSpace:           7,
+		BaseDeviceSize:         8,
+		UdevSyncSupported:      true,
+		DeferredRemoval:        true,
+		DeferredDeletion:       true,
+		DeferredDeletedDevices: 9,
+	}
+	if !reflect.DeepEqual(devmapper, expected) {
+		t.Errorf("got devicemapper status %+v, want %+v", devmapper, expected)
+	}
+}
+
+func TestGraphdriverStatus(t *testing.T) {
+	s := &grpcImageService{daemonOptions: daemonOptions{gopts: &globalOptions{}}}
+	driver := &statusDriverFake{
+		pairs: [][2]string{{"Backing Filesystem", "xfs"}, {"Supports d_type", "true"}},
+		typed: &graphdriver.TypedStatus{
+			Overlay:  &graphdriver.OverlayStatus{BackingFilesystem: "xfs", SupportsDType: true},
+			Warnings: []string{"not using native diff for overlay"},
+		},
+	}
+	defer useStatusStore(&statusStoreFake{driver: driver})()
+
+	resp, err := s.GraphdriverStatus(context.Background(), &pb.GraphdriverStatusRequest{})
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if resp.DriverName != "fake" || resp.BackingFilesystem != "xfs" ||
+		resp.Status != "Backing Filesystem: xfs\nSupports d_type: true\n" {
+		t.Errorf("got status %+v", resp)
+	}
+	if resp.Overlay == nil || !resp.Overlay.SupportsDType || resp.Devicemapper != nil {
+		t.Errorf("got overlay %+v, devicemapper %+v, want overlay status only", resp.Overlay, resp.Devicemapper)
+	}
+	if !reflect.DeepEqual(resp.Warnings, driver.typed.Warnings) {
+		t.Errorf("got warnings %v, want %v", resp.Warnings, driver.typed.Warnings)
+	}
+
+	driver.pairs = nil
+	resp, err = s.GraphdriverStatus(context.Background(), &pb.GraphdriverStatusRequest{})
+	if err == nil || resp.Cc == 0 {
+		t.Errorf("status without backing filesystem should fail, got %+v, %v", resp, err)
+	}
+}
+
+func TestOverlayQuotaWarning(t *testing.T) {
+	dir, err := ioutil.TempDir("", "isulad-img-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	store, err := cstorage.GetStore(cstorage.StoreOptions{
+		RunRoot:         filepath.Join(dir, "run"),
+		GraphRoot:       filepath.Join(dir, "root"),
+		GraphDriverName: "overlay",
+	})
+	if err != nil {
+		t.Skipf("overlay is not supported: %v", err)
+	}
+	defer store.Shutdown(true)
+	defer useStatusStore(store)()
+
+	status, err := storageStatus(&globalOptions{})
+	if err != nil {
+		t.Skipf("overlay is not supported: %v", err)
+	}
+	if status.Typed == nil || status.Typed.Overlay == nil {
+		t.Fatalf("got status %+v, want typed overlay status", status)
+	}
+	if status.Typed.Overlay.ProjectQuota {
+		t.Skip("project quota is supported by the filesystem of the test")
+	}
+
+	quotaWarnings := func(warnings []string) int {
+		n := 0
+		for _, w := range warnings {
+			if strings.Contains(w, "project quota is not supported") {
+				n++
+			}
+		}
+		return n
+	}
+	if n := quotaWarnings(status.Typed.Warnings); n != 1 {
+		t.Errorf("got warnings %v, want project quota warned about in the first status", status.Typed.Warnings)
+	}
+	status, err = storageStatus(&globalOptions{})
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if n := quotaWarnings(status.Typed.Warnings); n != 0 || status.Typed.Overlay.ProjectQuota {
+		t.Errorf("got warnings %v, want project quota warned about once", status.Typed.Warnings)
+	}
+}
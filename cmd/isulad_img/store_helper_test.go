@@ -0,0 +1,159 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/image/manifest"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/reexec"
+	digest "github.com/opencontainers/go-digest"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// TestMain runs helpers of storage when the test binary is re-executed, e.g.
+// to apply layers in chroot
+func TestMain(m *testing.M) {
+	if reexec.Init() {
+		return
+	}
+	os.Exit(m.Run())
+}
+
+// newTestImageService returns an image service on a store in a temporary
+// directory using vfs driver, the store is removed by the returned function
+func newTestImageService(t *testing.T) (*imageService, func()) {
+	dir, err := ioutil.TempDir("", "isulad-img-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := storage.GetStore(storage.StoreOptions{
+		RunRoot:         filepath.Join(dir, "run"),
+		GraphRoot:       filepath.Join(dir, "root"),
+		GraphDriverName: "vfs",
+	})
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("failed to create store: %v", err)
+	}
+	svc, err := InitImageService(context.Background(), store, defaultTransport, nil, nil)
+	if err != nil {
+		store.Shutdown(true)
+		os.RemoveAll(dir)
+		t.Fatalf("failed to create image service: %v", err)
+	}
+
+	return svc.(*imageService), func() {
+		store.Shutdown(true)
+		os.RemoveAll(dir)
+	}
+}
+
+// useTestImageService makes svc the image service of the process, so that
+// functions getting the store and image service from globals use it. The
+// returned function restores the globals.
+func useTestImageService(svc *imageService) func() {
+	oldStore, oldImageService, oldContainerServer, oldImageCache := gStore, gImageService, gContainerServer, gImageCache
+	gStore, gImageService, gContainerServer, gImageCache = svc.store, svc, nil, &imageSummaryCache{}
+	return func() {
+		gStore, gImageService, gContainerServer, gImageCache = oldStore, oldImageService, oldContainerServer, oldImageCache
+	}
+}
+
+// testLayerTar returns a layer with a single file named "file"
+func testLayerTar(t *testing.T, content string) io.Reader {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: "file", Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+// createTestImage creates an image named name with a layer for each of
+// contents, the file in each layer has the content
+func createTestImage(t *testing.T, store storage.Store, name string, contents ...string) *storage.Image {
+	var (
+		parent  string
+		diffIDs []digest.Digest
+		layers  []manifest.Schema2Descriptor
+	)
+	for _, content := range contents {
+		layer, size, err := store.PutLayer("", parent, nil, "", false, nil, testLayerTar(t, content))
+		if err != nil {
+			t.Fatalf("failed to create layer: %v", err)
+		}
+		diffIDs = append(diffIDs, layer.UncompressedDigest)
+		layers = append(layers, manifest.Schema2Descriptor{
+			MediaType: manifest.DockerV2Schema2LayerMediaType,
+			Size:      size,
+			Digest:    layer.UncompressedDigest,
+		})
+		parent = layer.ID
+	}
+
+	config, err := json.Marshal(imgspecv1.Image{
+		Architecture: "amd64",
+		OS:           "linux",
+		RootFS:       imgspecv1.RootFS{Type: "layers", DiffIDs: diffIDs},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	configDigest := digest.FromBytes(config)
+	m, err := manifest.Schema2FromComponents(manifest.Schema2Descriptor{
+		MediaType: manifest.DockerV2Schema2ConfigMediaType,
+		Size:      int64(len(config)),
+		Digest:    configDigest,
+	}, layers).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	image, err := store.CreateImage(configDigest.Hex(), []string{name}, parent, "", &storage.ImageOptions{Digest: digest.FromBytes(m)})
+	if err != nil {
+		t.Fatalf("failed to create image %s: %v", name, err)
+	}
+	if err := store.SetImageBigData(image.ID, configDigest.String(), config); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetImageBigData(image.ID, storage.ImageDigestBigDataKey, m); err != nil {
+		t.Fatal(err)
+	}
+	return image
+}
+
+// corruptTestLayer changes the content of the file in layer, the layer is
+// stored by vfs driver in a directory named by its ID
+func corruptTestLayer(t *testing.T, store storage.Store, layerID string, content string) {
+	file := filepath.Join(store.GraphRoot(), "vfs", "dir", layerID, "file")
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
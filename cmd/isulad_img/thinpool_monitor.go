@@ -0,0 +1,309 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containers/storage"
+	graphdriver "github.com/containers/storage/drivers"
+	units "github.com/docker/go-units"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultThinPoolCheckInterval = 10 * time.Second
+	// the pool is extended by this percent of its size if step is not set
+	defaultThinPoolExtendStep = 10
+)
+
+var (
+	gThinPoolLock sync.RWMutex
+	// gThinPoolLowSpace is set while free space of the thin pool is below
+	// thinpool-min-free, containers can not be prepared until it is cleared
+	gThinPoolLowSpace error
+)
+
+// thinPoolAction is what to do for the current usage of the thin pool
+type thinPoolAction struct {
+	// extendData and extendMetadata are sizes in bytes to extend the pool by
+	extendData     uint64
+	extendMetadata uint64
+	lowSpace       error
+}
+
+// thinPoolValue returns the value of w for a space of the pool of size total.
+// Bytes are for the data space, they are scaled to the metadata space by the
+// ratio of its size to the data space.
+func thinPoolValue(w watermark, total, dataTotal uint64) uint64 {
+	if w.percent != 0 || dataTotal == 0 {
+		return w.value(total)
+	}
+	return uint64(float64(w.bytes) * float64(total) / float64(dataTotal))
+}
+
+// extendBy returns how much a space of the pool of size total should be
+// extended by, at most what is left of limit, the size the space may grow to
+func extendBy(step, total, limit uint64) uint64 {
+	if limit == 0 {
+		return step
+	}
+	if total >= limit {
+		return 0
+	}
+	if left := limit - total; left < step {
+		return left
+	}
+	return step
+}
+
+// loopbackLimit returns the size a space of the pool on a loop file may grow
+// to. Loop files are sparse, only the used space is stored in them and the
+// rest has to fit in available, the free space of the filesystem of the file.
+func loopbackLimit(used, total, available uint64) uint64 {
+	if used+available < total {
+		return total
+	}
+	return used + available
+}
+
+// checkThinPool decides whether the pool should be extended and whether its
+// free space is below the floor according to config. A threshold in percent
+// is compared with usage of the pool, a threshold in bytes is a floor of its
+// free space. base is the status of the pool when monitoring started, the
+// pool does not grow by more than thinpool-extend-max over it. If that is
+// not set, a pool on loop files does not grow beyond loopbackAvailable, the
+// free space of the filesystem holding the files.
+func checkThinPool(config *daemonConfig, base, status *graphdriver.DevmapperStatus, loopbackAvailable uint64) thinPoolAction {
+	var action thinPoolAction
+	// Usage is unknown if it failed to get status of the pool
+	if status == nil || status.DataTotal == 0 || status.MetadataTotal == 0 {
+		return action
+	}
+
+	if threshold := config.ThinPoolExtendThreshold; threshold.isSet() {
+		step := config.ThinPoolExtendStep
+		if !step.isSet() {
+			step = watermark{percent: defaultThinPoolExtendStep}
+		}
+		var dataLimit, metadataLimit uint64
+		if extendMax := config.ThinPoolExtendMax; extendMax.isSet() {
+			if base != nil && base.DataTotal != 0 {
+				dataLimit = base.DataTotal + thinPoolValue(extendMax, base.DataTotal, base.DataTotal)
+				metadataLimit = base.MetadataTotal + thinPoolValue(extendMax, base.MetadataTotal, base.DataTotal)
+			}
+		} else if status.DataLoopback != "" {
+			dataLimit = loopbackLimit(status.DataUsed, status.DataTotal, loopbackAvailable)
+			metadataLimit = loopbackLimit(status.MetadataUsed, status.MetadataTotal, loopbackAvailable)
+		}
+
+		dataLow := status.DataUsed >= threshold.value(status.DataTotal)
+		metadataLow := status.MetadataUsed >= threshold.value(status.MetadataTotal)
+		if threshold.percent == 0 {
+			dataLow = status.DataAvailable < thinPoolValue(threshold, status.DataTotal, status.DataTotal)
+			metadataLow = status.MetadataAvailable < thinPoolValue(threshold, status.MetadataTotal, status.DataTotal)
+		}
+		if dataLow {
+			action.extendData = extendBy(thinPoolValue(step, status.DataTotal, status.DataTotal),
+				status.DataTotal, dataLimit)
+		}
+		if metadataLow {
+			action.extendMetadata = extendBy(thinPoolValue(step, status.MetadataTotal, status.DataTotal),
+				status.MetadataTotal, metadataLimit)
+		}
+	}
+
+	if minFree := config.ThinPoolMinFree; minFree.isSet() {
+		if floor := thinPoolValue(minFree, status.DataTotal, status.DataTotal); status.DataAvailable < floor {
+			action.lowSpace = fmt.Errorf("thin pool %s is running out of data space, %s available is below thinpool-min-free %s",
+				status.PoolName, units.BytesSize(float64(status.DataAvailable)), units.BytesSize(float64(floor)))
+		} else if floor := thinPoolValue(minFree, status.MetadataTotal, status.DataTotal); status.MetadataAvailable < floor {
+			action.lowSpace = fmt.Errorf("thin pool %s is running out of metadata space, %s available is below thinpool-min-free %s",
+				status.PoolName, units.BytesSize(float64(status.MetadataAvailable)), units.BytesSize(float64(floor)))
+		}
+	}
+
+	return action
+}
+
+func setThinPoolLowSpace(lowSpace error) {
+	gThinPoolLock.Lock()
+	defer gThinPoolLock.Unlock()
+
+	if lowSpace != nil && gThinPoolLowSpace == nil {
+		logrus.Errorf("Preparing containers is blocked: %v", lowSpace)
+	} else if lowSpace == nil && gThinPoolLowSpace != nil {
+		logrus.Infof("Free space of thin pool is above thinpool-min-free, preparing containers is unblocked")
+	}
+	gThinPoolLowSpace = lowSpace
+}
+
+// checkThinPoolSpace returns error if containers can not be prepared because
+// the thin pool is running out of space
+func checkThinPoolSpace() error {
+	gThinPoolLock.RLock()
+	defer gThinPoolLock.RUnlock()
+
+	if gThinPoolLowSpace != nil {
+		return fmt.Errorf("Can not prepare container: %v", gThinPoolLowSpace)
+	}
+	return nil
+}
+
+// thinPoolStatus returns status of the thin pool, nil if the driver is not devicemapper
+func thinPoolStatus(driver graphdriver.Driver) *graphdriver.DevmapperStatus {
+	reporter, ok := graphdriver.Unwrap(driver).(graphdriver.TypedStatusReporter)
+	if !ok {
+		return nil
+	}
+	return reporter.TypedStatus().Devmapper
+}
+
+// loopbackAvailable returns free space of the filesystems holding the loop
+// files of the pool, the smaller one if they are on different filesystems
+func loopbackAvailable(status *graphdriver.DevmapperStatus) (uint64, error) {
+	var available uint64
+	for i, file := range []string{status.DataLoopback, status.MetadataLoopback} {
+		used, size, err := filesystemUsage(file)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 || size-used < available {
+			available = size - used
+		}
+	}
+	return available, nil
+}
+
+// lvmName returns the volume group and logical volume of a device mapper
+// device created by LVM. LVM names it "<vg>-<lv>" with "-" in the names
+// doubled, and adds "-tpool" to thin pools used by thin volumes.
+func lvmName(dmName string) (string, string, error) {
+	for i := 0; i < len(dmName); i++ {
+		if dmName[i] != '-' {
+			continue
+		}
+		if i+1 < len(dmName) && dmName[i+1] == '-' {
+			i++
+			continue
+		}
+		if i == 0 || i == len(dmName)-1 {
+			break
+		}
+		vg := strings.Replace(dmName[:i], "--", "-", -1)
+		lv := strings.TrimSuffix(dmName[i+1:], "-tpool")
+		return vg, strings.Replace(lv, "--", "-", -1), nil
+	}
+	return "", "", fmt.Errorf("%s is not a logical volume of LVM", dmName)
+}
+
+// extendLVMPool grows the logical volume of the pool by lvextend, the kernel
+// picks up the new size of the pool without help of the driver
+func extendLVMPool(poolName string, dataSize, metadataSize uint64) error {
+	vg, lv, err := lvmName(poolName)
+	if err != nil {
+		return err
+	}
+
+	if dataSize > 0 {
+		out, err := exec.Command("lvextend", "--size", fmt.Sprintf("+%db", dataSize), vg+"/"+lv).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to extend data of %s/%s: %v: %s", vg, lv, err, strings.TrimSpace(string(out)))
+		}
+	}
+	if metadataSize > 0 {
+		out, err := exec.Command("lvextend", "--poolmetadatasize", fmt.Sprintf("+%db", metadataSize), vg+"/"+lv).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to extend metadata of %s/%s: %v: %s", vg, lv, err, strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
+
+// extendThinPool grows the loop files of the pool by the driver, or the
+// logical volume of the pool by lvextend
+func extendThinPool(driver graphdriver.Driver, status *graphdriver.DevmapperStatus, action thinPoolAction) error {
+	if status.DataLoopback == "" {
+		return extendLVMPool(status.PoolName, action.extendData, action.extendMetadata)
+	}
+	extender, ok := graphdriver.Unwrap(driver).(graphdriver.PoolExtender)
+	if !ok {
+		return fmt.Errorf("graph driver %s can not extend thin pool", driver.String())
+	}
+	return extender.ExtendPool(action.extendData, action.extendMetadata)
+}
+
+// monitorThinPool extends the thin pool if its usage is above the threshold,
+// and blocks preparing containers while its free space is below the floor
+func monitorThinPool(driver graphdriver.Driver, base *graphdriver.DevmapperStatus) {
+	config := getDaemonConfig()
+	status := thinPoolStatus(driver)
+	var available uint64
+	if status != nil && status.DataLoopback != "" {
+		var err error
+		// The pool is not extended if free space of the files is unknown
+		if available, err = loopbackAvailable(status); err != nil {
+			logrus.Warnf("Failed to get free space for loop files of thin pool %s: %v", status.PoolName, err)
+		}
+	}
+	action := checkThinPool(config, base, status, available)
+
+	if action.extendData > 0 || action.extendMetadata > 0 {
+		logrus.Warnf("Thin pool %s uses %s of data space and %s of metadata space, extending it by %s and %s", status.PoolName,
+			units.BytesSize(float64(status.DataUsed)), units.BytesSize(float64(status.MetadataUsed)),
+			units.BytesSize(float64(action.extendData)), units.BytesSize(float64(action.extendMetadata)))
+		if err := extendThinPool(driver, status, action); err != nil {
+			logrus.Errorf("Failed to extend thin pool %s: %v", status.PoolName, err)
+		} else {
+			action = checkThinPool(config, base, thinPoolStatus(driver), available)
+		}
+	}
+
+	setThinPoolLowSpace(action.lowSpace)
+}
+
+// startThinPoolMonitor checks usage of the thin pool periodically until the
+// daemon shuts down if the graph driver is devicemapper. Thresholds are taken
+// from the current daemon config, nothing is done if none of them is set.
+func startThinPoolMonitor(store storage.Store) error {
+	driver, err := store.GraphDriver()
+	if err != nil {
+		return err
+	}
+	base := thinPoolStatus(driver)
+	if base == nil {
+		return nil
+	}
+
+	goBackground("thin pool monitor", func() {
+		for {
+			monitorThinPool(driver, base)
+			interval := time.Duration(getDaemonConfig().ThinPoolCheckInterval)
+			if interval == 0 {
+				interval = defaultThinPoolCheckInterval
+			}
+			select {
+			case <-backgroundCtx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	graphdriver "github.com/containers/storage/drivers"
+)
+
+func TestCheckThinPool(t *testing.T) {
+	const gb = 1024 * 1024 * 1024
+	pool := func(dataUsed, metadataUsed uint64) *graphdriver.DevmapperStatus {
+		return &graphdriver.DevmapperStatus{
+			PoolName:          "pool",
+			DataUsed:          dataUsed,
+			DataTotal:         100 * gb,
+			DataAvailable:     100*gb - dataUsed,
+			MetadataUsed:      metadataUsed,
+			MetadataTotal:     gb,
+			MetadataAvailable: gb - metadataUsed,
+		}
+	}
+
+	cases := []struct {
+		name                       string
+		config                     daemonConfig
+		status                     *graphdriver.DevmapperStatus
+		extendData, extendMetadata uint64
+		lowSpace                   bool
+	}{
+		{"disabled", daemonConfig{}, pool(99*gb, gb/2), 0, 0, false},
+		{"unknown usage", daemonConfig{ThinPoolMinFree: watermark{bytes: gb}}, &graphdriver.DevmapperStatus{}, 0, 0, false},
+		{"below threshold", daemonConfig{ThinPoolExtendThreshold: watermark{percent: 80}}, pool(50*gb, gb/2), 0, 0, false},
+		{"default step", daemonConfig{ThinPoolExtendThreshold: watermark{percent: 80}}, pool(80*gb, gb/2), 10 * gb, 0, false},
+		{"metadata", daemonConfig{ThinPoolExtendThreshold: watermark{percent: 80}, ThinPoolExtendStep: watermark{percent: 20}},
+			pool(50*gb, gb*9/10), 0, gb / 5, false},
+		{"bytes", daemonConfig{ThinPoolExtendThreshold: watermark{bytes: 10 * gb}, ThinPoolExtendStep: watermark{bytes: 50 * gb}},
+			pool(95*gb, gb*95/100), 50 * gb, gb / 2, false},
+		{"bytes above floor", daemonConfig{ThinPoolExtendThreshold: watermark{bytes: 10 * gb}}, pool(85*gb, gb*85/100), 0, 0, false},
+		{"max", daemonConfig{ThinPoolExtendThreshold: watermark{percent: 80}, ThinPoolExtendMax: watermark{bytes: 5 * gb}},
+			pool(90*gb, gb*9/10), 5 * gb, gb / 20, false},
+		{"data floor", daemonConfig{ThinPoolMinFree: watermark{percent: 5}}, pool(96*gb, gb/2), 0, 0, true},
+		{"metadata floor", daemonConfig{ThinPoolMinFree: watermark{bytes: 5 * gb}}, pool(50*gb, gb*96/100), 0, 0, true},
+		{"above floor", daemonConfig{ThinPoolMinFree: watermark{bytes: 5 * gb}}, pool(94*gb, gb/2), 0, 0, false},
+	}
+	for _, c := range cases {
+		action := checkThinPool(&c.config, pool(0, 0), c.status, 0)
+		if action.extendData != c.extendData || action.extendMetadata != c.extendMetadata {
+			t.Errorf("%s: extend by %d and %d, want %d and %d", c.name,
+				action.extendData, action.extendMetadata, c.extendData, c.extendMetadata)
+		}
+		if (action.lowSpace != nil) != c.lowSpace {
+			t.Errorf("%s: low space %v, want %v", c.name, action.lowSpace, c.lowSpace)
+		}
+	}
+}
+
+// extend applies action to status as the driver would
+func extend(status *graphdriver.DevmapperStatus, action thinPoolAction) {
+	status.DataTotal += action.extendData
+	status.DataAvailable += action.extendData
+	status.MetadataTotal += action.extendMetadata
+	status.MetadataAvailable += action.extendMetadata
+}
+
+func TestCheckThinPoolSettles(t *testing.T) {
+	const gb = 1024 * 1024 * 1024
+	base := &graphdriver.DevmapperStatus{
+		PoolName:          "pool",
+		DataTotal:         100 * gb,
+		DataAvailable:     100 * gb,
+		MetadataTotal:     gb,
+		MetadataAvailable: gb,
+	}
+
+	configs := []daemonConfig{
+		{ThinPoolExtendThreshold: watermark{bytes: 10 * gb}, ThinPoolExtendStep: watermark{bytes: 20 * gb}},
+		{ThinPoolExtendThreshold: watermark{percent: 90}, ThinPoolExtendStep: watermark{percent: 20}},
+	}
+	for _, config := range configs {
+		status := *base
+		status.DataUsed = 95 * gb
+		status.DataAvailable = 5 * gb
+		action := checkThinPool(&config, base, &status, 0)
+		if action.extendData == 0 {
+			t.Fatalf("%+v: pool is not extended", config)
+		}
+		extend(&status, action)
+		if action = checkThinPool(&config, base, &status, 0); action.extendData != 0 || action.extendMetadata != 0 {
+			t.Errorf("%+v: pool is extended again by %d and %d", config, action.extendData, action.extendMetadata)
+		}
+	}
+
+	// Growth stops at thinpool-extend-max even if the pool is still full
+	config := daemonConfig{ThinPoolExtendThreshold: watermark{percent: 50}, ThinPoolExtendMax: watermark{bytes: 25 * gb}}
+	status := *base
+	var extended uint64
+	for i := 0; i < 10; i++ {
+		status.DataUsed = status.DataTotal
+		status.DataAvailable = 0
+		action := checkThinPool(&config, base, &status, 0)
+		extend(&status, action)
+		extended += action.extendData
+	}
+	if extended != 25*gb || status.DataTotal != 125*gb {
+		t.Errorf("pool is extended by %d to %d, want %d to %d", extended, status.DataTotal, 25*gb, 125*gb)
+	}
+}
+
+func TestCheckThinPoolLoopback(t *testing.T) {
+	const gb = 1024 * 1024 * 1024
+	base := &graphdriver.DevmapperStatus{
+		PoolName:          "pool",
+		DataLoopback:      "/var/lib/isulad/storage/devicemapper/devicemapper/data",
+		MetadataLoopback:  "/var/lib/isulad/storage/devicemapper/devicemapper/metadata",
+		DataTotal:         100 * gb,
+		DataAvailable:     100 * gb,
+		MetadataTotal:     2 * gb,
+		MetadataAvailable: 2 * gb,
+	}
+
+	// Without thinpool-extend-max the loop files may hold what is used of the
+	// pool and free space of their filesystem
+	config := daemonConfig{ThinPoolExtendThreshold: watermark{percent: 80}}
+	status := *base
+	var extended uint64
+	for i := 0; i < 10; i++ {
+		status.DataUsed = status.DataTotal * 9 / 10
+		status.DataAvailable = status.DataTotal - status.DataUsed
+		action := checkThinPool(&config, base, &status, 30*gb)
+		extend(&status, action)
+		extended += action.extendData
+	}
+	if status.DataTotal > status.DataUsed+30*gb || extended == 0 {
+		t.Errorf("pool is extended by %d to %d with %d used, want at most %d", extended, status.DataTotal, status.DataUsed, status.DataUsed+30*gb)
+	}
+
+	// Nothing is extended if the filesystem is full
+	status = *base
+	status.DataUsed = 90 * gb
+	status.DataAvailable = 10 * gb
+	status.MetadataUsed = 2 * gb * 9 / 10
+	status.MetadataAvailable = 2*gb - status.MetadataUsed
+	if action := checkThinPool(&config, base, &status, 0); action.extendData != 0 || action.extendMetadata != 0 {
+		t.Errorf("pool on full filesystem is extended by %d and %d", action.extendData, action.extendMetadata)
+	}
+
+	// thinpool-extend-max replaces the default limit
+	config.ThinPoolExtendMax = watermark{bytes: 50 * gb}
+	if action := checkThinPool(&config, base, &status, 0); action.extendData != 10*gb {
+		t.Errorf("pool is extended by %d, want %d", action.extendData, 10*gb)
+	}
+}
+
+func TestLoopbackAvailable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thinpool")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	status := &graphdriver.DevmapperStatus{DataLoopback: dir, MetadataLoopback: dir}
+	available, err := loopbackAvailable(status)
+	if err != nil {
+		t.Fatalf("failed to get free space of %s: %v", dir, err)
+	}
+	used, size, _ := filesystemUsage(dir)
+	if available == 0 || available > size || available+used > size+size/100 {
+		t.Errorf("free space %d does not match filesystem of size %d with %d used", available, size, used)
+	}
+
+	status.MetadataLoopback = filepath.Join(dir, "missing")
+	if _, err := loopbackAvailable(status); err == nil {
+		t.Errorf("free space of missing loop file should fail")
+	}
+}
+
+func TestLvmName(t *testing.T) {
+	cases := []struct {
+		dmName, vg, lv string
+	}{
+		{"storage-thinpool", "storage", "thinpool"},
+		{"docker--vg-thin--pool", "docker-vg", "thin-pool"},
+		{"vg-pool-tpool", "vg", "pool"},
+		{"thinpool", "", ""},
+		{"-pool", "", ""},
+	}
+	for _, c := range cases {
+		vg, lv, err := lvmName(c.dmName)
+		if c.vg == "" {
+			if err == nil {
+				t.Errorf("lvmName(%q) should fail", c.dmName)
+			}
+			continue
+		}
+		if err != nil || vg != c.vg || lv != c.lv {
+			t.Errorf("lvmName(%q) = %q, %q, %v, want %q, %q", c.dmName, vg, lv, err, c.vg, c.lv)
+		}
+	}
+}
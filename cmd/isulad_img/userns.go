@@ -0,0 +1,99 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/idtools"
+)
+
+const (
+	// defaultAutoUsernsUser is the user whose subordinate IDs in /etc/subuid
+	// and /etc/subgid are allocated to containers
+	defaultAutoUsernsUser = "containers"
+	defaultAutoUsernsSize = 65536
+)
+
+// gUsernsLock serializes allocating ID mappings and creating containers with
+// them, so the same IDs are not allocated to two containers
+var gUsernsLock sync.Mutex
+
+// validateIDMaps checks ID mappings requested for a container
+func validateIDMaps(uidMap, gidMap []idtools.IDMap) error {
+	if (len(uidMap) == 0) != (len(gidMap) == 0) {
+		return fmt.Errorf("uid mappings and gid mappings must be set together")
+	}
+	for _, m := range append(uidMap, gidMap...) {
+		if m.Size <= 0 || m.ContainerID < 0 || m.HostID < 0 {
+			return fmt.Errorf("invalid ID mapping %d:%d:%d", m.ContainerID, m.HostID, m.Size)
+		}
+	}
+	return nil
+}
+
+// allocateIDRange returns the first range of size IDs in the available host
+// ranges which does not overlap with any used host range
+func allocateIDRange(avail, used []idtools.IDMap, size int) (int, error) {
+	sort.Slice(used, func(i, j int) bool { return used[i].HostID < used[j].HostID })
+	for _, r := range avail {
+		start := r.HostID
+		for _, u := range used {
+			if u.HostID+u.Size <= start {
+				continue
+			}
+			if u.HostID >= start+size {
+				break
+			}
+			start = u.HostID + u.Size
+		}
+		if start+size <= r.HostID+r.Size {
+			return start, nil
+		}
+	}
+	return 0, fmt.Errorf("no free range of %d IDs left in subordinate IDs", size)
+}
+
+// autoIDMappings allocates ID mappings of size IDs to a container from the
+// subordinate IDs of user, skipping IDs used by existing containers
+func autoIDMappings(store storage.Store, user string, size int) ([]idtools.IDMap, []idtools.IDMap, error) {
+	mappings, err := idtools.NewIDMappings(user, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	containers, err := store.Containers()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var usedUIDs, usedGIDs []idtools.IDMap
+	for _, c := range containers {
+		usedUIDs = append(usedUIDs, c.UIDMap...)
+		usedGIDs = append(usedGIDs, c.GIDMap...)
+	}
+	uid, err := allocateIDRange(mappings.UIDs(), usedUIDs, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to allocate uid mappings: %v", err)
+	}
+	gid, err := allocateIDRange(mappings.GIDs(), usedGIDs, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to allocate gid mappings: %v", err)
+	}
+
+	return []idtools.IDMap{{ContainerID: 0, HostID: uid, Size: size}},
+		[]idtools.IDMap{{ContainerID: 0, HostID: gid, Size: size}}, nil
+}
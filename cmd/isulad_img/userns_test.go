@@ -0,0 +1,70 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"testing"
+
+	"github.com/containers/storage/pkg/idtools"
+)
+
+func TestAllocateIDRange(t *testing.T) {
+	avail := []idtools.IDMap{
+		{ContainerID: 0, HostID: 100000, Size: 200000},
+		{ContainerID: 200000, HostID: 1000000, Size: 65536},
+	}
+	cases := []struct {
+		name  string
+		used  []idtools.IDMap
+		size  int
+		start int
+		fail  bool
+	}{
+		{"empty", nil, 65536, 100000, false},
+		{"after used", []idtools.IDMap{{HostID: 100000, Size: 65536}}, 65536, 165536, false},
+		{"gap", []idtools.IDMap{{HostID: 100000, Size: 1000}, {HostID: 170000, Size: 1000}}, 65536, 101000, false},
+		{"overlap from left", []idtools.IDMap{{HostID: 50000, Size: 60000}}, 65536, 110000, false},
+		{"next range", []idtools.IDMap{{HostID: 100000, Size: 150000}}, 65536, 1000000, false},
+		{"unsorted used", []idtools.IDMap{{HostID: 165536, Size: 65536}, {HostID: 100000, Size: 65536}}, 65536, 231072, false},
+		{"full", []idtools.IDMap{{HostID: 100000, Size: 200000}, {HostID: 1000000, Size: 1}}, 65536, 0, true},
+	}
+	for _, c := range cases {
+		start, err := allocateIDRange(avail, c.used, c.size)
+		if c.fail {
+			if err == nil {
+				t.Errorf("%s: allocated %d, want error", c.name, start)
+			}
+			continue
+		}
+		if err != nil || start != c.start {
+			t.Errorf("%s: allocated %d, %v, want %d", c.name, start, err, c.start)
+		}
+	}
+}
+
+func TestValidateIDMaps(t *testing.T) {
+	m := []idtools.IDMap{{ContainerID: 0, HostID: 100000, Size: 65536}}
+	if err := validateIDMaps(nil, nil); err != nil {
+		t.Errorf("no mappings should be valid: %v", err)
+	}
+	if err := validateIDMaps(m, m); err != nil {
+		t.Errorf("%v should be valid: %v", m, err)
+	}
+	if err := validateIDMaps(m, nil); err == nil {
+		t.Errorf("uid mappings without gid mappings should be invalid")
+	}
+	if err := validateIDMaps(m, []idtools.IDMap{{ContainerID: 0, HostID: 100000, Size: 0}}); err == nil {
+		t.Errorf("mapping of 0 IDs should be invalid")
+	}
+}
@@ -63,6 +63,12 @@ type globalOptions struct {
 	InsecurePolicy     bool
 	CmdTimeout         time.Duration
 	TLSVerify          bool
+	PartialBlobMaxAge  time.Duration
+	RetryPolicy        retryPolicy
+	// CertsDir is the directory of per registry certificates, used by daemon only
+	CertsDir string
+	// IntegrationCheck controls the image integrity check, fast mode is used by daemon only
+	IntegrationCheck integrationCheckOptions
 
 	Daemon bool
 }
@@ -324,5 +330,6 @@ func getGlobalOptions(c *cli.Context) (*globalOptions, error) {
 		InsecurePolicy:     c.GlobalBool("insecure-policy"),
 		CmdTimeout:         c.GlobalDuration("command-timeout"),
 		TLSVerify:          tlsVerify(c, ""),
+		RetryPolicy:        defaultRetryPolicy(),
 	}, nil
 }
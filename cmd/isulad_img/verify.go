@@ -0,0 +1,384 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	imstorage "github.com/containers/image/storage"
+	"github.com/containers/image/types"
+	"github.com/containers/storage"
+	"github.com/containers/storage/pkg/archive"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// quarantineFlag is the image flag set on images failed verification, its
+// value is the reason. Quarantined images can not be used by containers.
+const quarantineFlag = "quarantined"
+
+// layerVerifyResult is the verification result of a single layer
+type layerVerifyResult struct {
+	ID string `json:"id"`
+	// DiffID is the diffID of the layer recorded in image config
+	DiffID string `json:"diff_id"`
+	// ComputedDiffID is the digest of the layer content, empty if it can not be read
+	ComputedDiffID string `json:"computed_diff_id,omitempty"`
+	OK             bool   `json:"ok"`
+	Error          string `json:"error,omitempty"`
+	// Repaired is set if the layer was fetched and extracted again
+	Repaired bool `json:"repaired,omitempty"`
+}
+
+// imageVerifyResult is the verification result of an image
+type imageVerifyResult struct {
+	ID          string              `json:"id"`
+	RepoTags    []string            `json:"repo_tags,omitempty"`
+	OK          bool                `json:"ok"`
+	Quarantined bool                `json:"quarantined"`
+	Error       string              `json:"error,omitempty"`
+	RepairError string              `json:"repair_error,omitempty"`
+	Layers      []layerVerifyResult `json:"layers,omitempty"`
+}
+
+// verifyOptions are options of verifying images
+type verifyOptions struct {
+	// quarantine marks images still failed after verification (and repair) unusable
+	quarantine bool
+	// repair re-fetches failed layers from RepoDigests of the image, or from archive if set
+	repair  bool
+	archive string
+}
+
+// isQuarantined returns the reason if the image is quarantined
+func isQuarantined(image *storage.Image) (string, bool) {
+	if image == nil || image.Flags == nil {
+		return "", false
+	}
+	reason, ok := image.Flags[quarantineFlag]
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("%v", reason), true
+}
+
+// imageLayers returns layers of the image from the base layer to the top layer
+func imageLayers(store storage.Store, image *storage.Image) ([]string, error) {
+	var layers []string
+	for id := image.TopLayer; id != ""; {
+		layer, err := store.Layer(id)
+		if err != nil {
+			return nil, fmt.Errorf("layer %s: %v", id, err)
+		}
+		layers = append([]string{layer.ID}, layers...)
+		id = layer.Parent
+	}
+	return layers, nil
+}
+
+// imageLayerSet returns all layers of the image, including the layers of
+// alternate versions of the top layer created for containers with ID mappings
+func imageLayerSet(store storage.Store, image *storage.Image) ([]string, error) {
+	layers, err := imageLayers(store, image)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, id := range layers {
+		seen[id] = true
+	}
+	for _, top := range image.MappedTopLayers {
+		for id := top; id != "" && !seen[id]; {
+			layer, err := store.Layer(id)
+			if err != nil {
+				return nil, fmt.Errorf("mapped layer %s: %v", id, err)
+			}
+			seen[layer.ID] = true
+			layers = append(layers, layer.ID)
+			id = layer.Parent
+		}
+	}
+	return layers, nil
+}
+
+// computeDiffID recomputes the diffID of a layer from its content in store
+func computeDiffID(store storage.Store, layerID string) (digest.Digest, error) {
+	uncompressed := archive.Uncompressed
+	rc, err := store.Diff("", layerID, &storage.DiffOptions{Compression: &uncompressed})
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	digester := digest.Canonical.Digester()
+	if _, err := io.Copy(digester.Hash(), rc); err != nil {
+		return "", err
+	}
+	return digester.Digest(), nil
+}
+
+func (svc *imageService) verifyImage(image *storage.Image) imageVerifyResult {
+	result := imageVerifyResult{ID: image.ID, RepoTags: image.Names}
+	_, result.Quarantined = isQuarantined(image)
+
+	config, err := getImageConf(svc.store, image.ID)
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to read image config: %v", err)
+		return result
+	}
+	layers, err := imageLayers(svc.store, image)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	diffIDs := config.RootFS.DiffIDs
+	if len(layers) != len(diffIDs) {
+		result.Error = fmt.Sprintf("image has %d layers but config has %d diffIDs", len(layers), len(diffIDs))
+	}
+
+	result.OK = result.Error == ""
+	for i, layerID := range layers {
+		lr := layerVerifyResult{ID: layerID}
+		if i < len(diffIDs) {
+			lr.DiffID = diffIDs[i].String()
+		}
+		computed, err := computeDiffID(svc.store, layerID)
+		if err != nil {
+			lr.Error = fmt.Sprintf("failed to read layer: %v", err)
+		} else {
+			lr.ComputedDiffID = computed.String()
+			if lr.DiffID == "" {
+				lr.Error = "no diffID in image config"
+			} else if lr.ComputedDiffID != lr.DiffID {
+				lr.Error = "diffID mismatch"
+			} else {
+				lr.OK = true
+			}
+		}
+		if !lr.OK {
+			logrus.Warnf("Verify layer %s of image %s failed: %s", layerID, image.ID, lr.Error)
+			result.OK = false
+		}
+		result.Layers = append(result.Layers, lr)
+	}
+
+	return result
+}
+
+// releaseQuarantine releases the image of result from quarantine
+func releaseQuarantine(store storage.Store, result *imageVerifyResult) {
+	if err := store.ClearImageFlag(result.ID, quarantineFlag); err != nil {
+		logrus.Errorf("Failed to release image %s from quarantine: %v", result.ID, err)
+		return
+	}
+	logrus.Infof("Image %s released from quarantine", result.ID)
+	result.Quarantined = false
+}
+
+// VerifyImages verifies the image, or all images if imageName is empty, by
+// recomputing diffIDs of their layers. If quarantine is set, failed images are
+// quarantined and images passed verification are released from quarantine,
+// otherwise quarantine of images is left as it is.
+func (svc *imageService) VerifyImages(systemContext *types.SystemContext, imageName string, quarantine bool) ([]imageVerifyResult, error) {
+	var images []storage.Image
+	if imageName != "" {
+		ref, err := svc.parseImageName(imageName)
+		if err != nil {
+			return nil, err
+		}
+		image, err := imstorage.Transport.GetStoreImage(svc.store, ref)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, *image)
+	} else {
+		var err error
+		images, err = svc.store.Images()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var results []imageVerifyResult
+	for i := range images {
+		image := &images[i]
+		logrus.Debugf("Try to verify image %s", image.ID)
+		result := svc.verifyImage(image)
+		if !result.OK && quarantine && !result.Quarantined {
+			reason := "verification failed"
+			if result.Error != "" {
+				reason = result.Error
+			}
+			if err := svc.store.SetImageFlag(image.ID, quarantineFlag, reason); err != nil {
+				logrus.Errorf("Failed to quarantine image %s: %v", image.ID, err)
+			} else {
+				logrus.Warnf("Image %s quarantined: %s", image.ID, reason)
+				result.Quarantined = true
+			}
+		} else if result.OK && quarantine && result.Quarantined {
+			releaseQuarantine(svc.store, &result)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// repairImage repairs the image of result and verifies it again
+func repairImage(gopts *globalOptions, imageService ImageServer, result imageVerifyResult, vopts *verifyOptions, archiveSources []repairSource) imageVerifyResult {
+	var (
+		repairErr string
+		err       error
+	)
+	sources := archiveSources
+	if vopts.archive == "" {
+		sources, err = registryRepairSources(gopts, imageService, result.ID)
+	}
+	if err == nil {
+		err = imageService.RepairImage(&result, sources)
+	}
+	if err != nil {
+		repairErr = err.Error()
+	}
+	repaired := make(map[string]bool)
+	for _, layer := range result.Layers {
+		repaired[layer.ID] = layer.Repaired
+	}
+
+	results, err := imageService.VerifyImages(&types.SystemContext{}, result.ID, vopts.quarantine)
+	if err != nil || len(results) != 1 {
+		result.RepairError = fmt.Sprintf("failed to verify image after repair: %v", err)
+		return result
+	}
+	result = results[0]
+	// Images repaired are usable again even if quarantine is not requested
+	if result.OK && result.Quarantined {
+		releaseQuarantine(imageService.GetStore(), &result)
+	}
+	result.RepairError = repairErr
+	for i := range result.Layers {
+		result.Layers[i].Repaired = repaired[result.Layers[i].ID]
+	}
+
+	return result
+}
+
+func verifyImages(gopts *globalOptions, imageName string, vopts *verifyOptions) ([]imageVerifyResult, error) {
+	imageService, err := getImageService(gopts)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vopts.repair {
+		return imageService.VerifyImages(&types.SystemContext{}, imageName, vopts.quarantine)
+	}
+
+	var archiveSources []repairSource
+	if vopts.archive != "" {
+		sources, release, err := archiveRepairSources(vopts.archive)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open archive %s: %v", vopts.archive, err)
+		}
+		defer release()
+		archiveSources = sources
+	}
+
+	// Quarantine after repair, so that images repaired are usable at once
+	results, err := imageService.VerifyImages(&types.SystemContext{}, imageName, false)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		if results[i].OK {
+			continue
+		}
+		results[i] = repairImage(gopts, imageService, results[i], vopts, archiveSources)
+	}
+
+	return results, nil
+}
+
+func verifyHandler(c *cli.Context) error {
+	image := ""
+	if len(c.Args()) > 0 {
+		image = c.Args().First()
+	}
+
+	gopts, err := getGlobalOptions(c)
+	if err != nil {
+		return err
+	}
+
+	vopts := &verifyOptions{
+		quarantine: c.Bool("quarantine"),
+		repair:     c.Bool("repair") || c.IsSet("archive"),
+		archive:    c.String("archive"),
+	}
+
+	var results []imageVerifyResult
+	sockAddr, err := isDaemonInstanceExist(defaultInfoFile)
+	if strings.Contains(err.Error(), daemonInstanceExist) {
+		results, err = grpcCliVerifyImages(sockAddr, image, vopts)
+	} else if os.IsNotExist(err) {
+		results, err = verifyImages(gopts, image, vopts)
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", data)
+
+	for _, result := range results {
+		if !result.OK {
+			return fmt.Errorf("image %s failed verification", result.ID)
+		}
+	}
+
+	return nil
+}
+
+var verifyCmd = cli.Command{
+	Name:  "verify",
+	Usage: "iSulad-img verify [IMAGE]",
+	Description: fmt.Sprintf(`
+
+	Verify layers of the image, or all images, against diffIDs in image config.
+
+	`),
+	ArgsUsage: "[IMAGE]",
+	Action:    verifyHandler,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "quarantine",
+			Usage: "mark images failed verification unusable instead of only reporting them",
+		},
+		cli.BoolFlag{
+			Name:  "repair",
+			Usage: "fetch failed layers again from the registries the image was pulled from",
+		},
+		cli.StringFlag{
+			Name:  "archive",
+			Usage: "fetch failed layers again from the image archive instead of registries",
+		},
+	},
+}
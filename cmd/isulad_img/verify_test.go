@@ -0,0 +1,140 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-23
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/containers/image/copy"
+	"github.com/containers/image/types"
+	"github.com/containers/storage"
+	"github.com/pkg/errors"
+)
+
+func TestVerifyImages(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	name := "docker.io/library/busybox:latest"
+	image := createTestImage(t, svc.store, name, "base", "top")
+
+	results, err := svc.VerifyImages(&types.SystemContext{}, name, false)
+	if err != nil || len(results) != 1 || !results[0].OK || len(results[0].Layers) != 2 {
+		t.Fatalf("got results %+v, %v, want image passed with 2 layers", results, err)
+	}
+
+	// Verification without quarantine leaves quarantine as it is
+	if err := svc.store.SetImageFlag(image.ID, quarantineFlag, "test"); err != nil {
+		t.Fatal(err)
+	}
+	results, err = svc.VerifyImages(&types.SystemContext{}, name, false)
+	if err != nil || len(results) != 1 || !results[0].OK || !results[0].Quarantined {
+		t.Errorf("got results %+v, %v, want image passed and still quarantined", results, err)
+	}
+	if img, err := svc.store.Image(image.ID); err != nil || img.Flags[quarantineFlag] == nil {
+		t.Errorf("quarantine of image should be kept without quarantine mode: %v", err)
+	}
+
+	results, err = svc.VerifyImages(&types.SystemContext{}, name, true)
+	if err != nil || len(results) != 1 || !results[0].OK || results[0].Quarantined {
+		t.Errorf("got results %+v, %v, want image released from quarantine", results, err)
+	}
+	if img, err := svc.store.Image(image.ID); err != nil || img.Flags[quarantineFlag] != nil {
+		t.Errorf("image should be released from quarantine: %v", err)
+	}
+
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+	results, err = svc.VerifyImages(&types.SystemContext{}, "", false)
+	if err != nil || len(results) != 1 || results[0].OK || results[0].Quarantined {
+		t.Fatalf("got results %+v, %v, want image failed and not quarantined", results, err)
+	}
+	if layers := results[0].Layers; len(layers) != 2 || !layers[0].OK || layers[1].OK || layers[1].ID != image.TopLayer {
+		t.Errorf("got layers %+v, want only the top layer failed", layers)
+	}
+
+	results, err = svc.VerifyImages(&types.SystemContext{}, "", true)
+	if err != nil || len(results) != 1 || results[0].OK || !results[0].Quarantined {
+		t.Errorf("got results %+v, %v, want image failed and quarantined", results, err)
+	}
+	img, err := svc.store.Image(image.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := isQuarantined(img); !ok {
+		t.Errorf("image should be quarantined")
+	}
+}
+
+func TestQuarantinedImageBlocked(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	defer useTestImageService(svc)()
+
+	name := "docker.io/library/busybox:latest"
+	image := createTestImage(t, svc.store, name, "base")
+	src := parsedImageNames{name: fmt.Sprintf("containers-storage:[vfs@%s+%s]%s", svc.store.GraphRoot(), svc.store.RunRoot(), name)}
+
+	if err := pullOneImage(svc, src, name, &copy.Options{}, 0); err != nil {
+		t.Errorf("pull of image already in store should be skipped: %v", err)
+	}
+
+	if err := svc.store.SetImageFlag(image.ID, quarantineFlag, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pullOneImage(svc, src, name, &copy.Options{}, 0); err == nil || !strings.Contains(err.Error(), "quarantined") {
+		t.Errorf("pull over quarantined image should fail, got %v", err)
+	}
+	if _, err := containerPrepare(&globalOptions{}, nil, name, "c1", "", &ContainerCreateOptions{}); err == nil || !strings.Contains(err.Error(), "quarantined") {
+		t.Errorf("container prepare of quarantined image should fail, got %v", err)
+	}
+	if containers, err := svc.store.Containers(); err != nil || len(containers) != 0 {
+		t.Errorf("got containers %v, %v, want none created from quarantined image", containers, err)
+	}
+}
+
+func TestRepairLayerMounted(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base", "top")
+	container, err := svc.store.CreateContainer("", nil, image.ID, "", "{}", nil)
+	if err != nil {
+		t.Fatalf("failed to create container: %v", err)
+	}
+	if _, err := svc.store.Mount(container.ID, ""); err != nil {
+		t.Fatalf("failed to mount container: %v", err)
+	}
+
+	corruptTestLayer(t, svc.store, image.TopLayer, "t0p")
+	if _, err := svc.store.RepairLayer(image.TopLayer, testLayerTar(t, "top")); errors.Cause(err) != storage.ErrLayerUsedByContainer {
+		t.Errorf("repair of layer under a mounted container should fail, got %v", err)
+	}
+	if _, err := svc.store.RepairLayer(image.ID, testLayerTar(t, "base")); err == nil {
+		t.Errorf("repair of unknown layer should fail")
+	}
+
+	if _, err := svc.store.Unmount(container.ID, true); err != nil {
+		t.Fatalf("failed to unmount container: %v", err)
+	}
+	if _, err := svc.store.RepairLayer(image.TopLayer, testLayerTar(t, "top")); err != nil {
+		t.Fatalf("failed to repair layer: %v", err)
+	}
+	results, err := svc.VerifyImages(&types.SystemContext{}, image.ID, false)
+	if err != nil || len(results) != 1 || !results[0].OK {
+		t.Errorf("got results %+v, %v, want image passed after repair", results, err)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Huawei Technologies Co., Ltd. 2020. All rights reserved.
+// iSulad-img licensed under the Mulan PSL v2.
+// You can use this software according to the terms and conditions of the Mulan PSL v2.
+// You may obtain a copy of Mulan PSL v2 at:
+//     http://license.coscl.org.cn/MulanPSL2
+// THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+// PURPOSE.
+// See the Mulan PSL v2 for more details.
+// Description: iSulad image kit
+// Author: wangfengtu
+// Create: 2020-08-27
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containers/storage"
+)
+
+// createTestContainer creates a container of image with storage options opts
+func createTestContainer(store storage.Store, imageID string, opts map[string]string) (*storage.Container, error) {
+	return store.CreateContainer("", nil, imageID, "", "{}", &storage.ContainerOptions{
+		Flags: map[string]interface{}{"StorageOpts": opts},
+	})
+}
+
+func TestVfsStorageOpt(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base")
+	for _, c := range []struct {
+		opts map[string]string
+		err  string
+	}{
+		{map[string]string{"size": "ten"}, "invalid size"},
+		{map[string]string{"size": "-1G"}, "invalid size"},
+		{map[string]string{"size": "1k"}, "4096 at least"},
+		{map[string]string{"inodes": "100"}, "Unknown option inodes"},
+	} {
+		if _, err := createTestContainer(svc.store, image.ID, c.opts); err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("create container with %v returned %v, want error %q", c.opts, err, c.err)
+		}
+	}
+}
+
+func TestVfsQuotaCreate(t *testing.T) {
+	svc, cleanup := newTestImageService(t)
+	defer cleanup()
+
+	image := createTestImage(t, svc.store, "docker.io/library/busybox:latest", "base", "top")
+	container, err := createTestContainer(svc.store, image.ID, map[string]string{"size": "1M"})
+	if err != nil {
+		if !strings.Contains(err.Error(), "supported only for vfs over xfs or ext4") {
+			t.Fatalf("got unexpected error %v", err)
+		}
+		t.Skip("project quota is not supported by the filesystem of the test")
+	}
+
+	// The copied image is not part of the usage or the limit
+	usage, err := getContainerStorageFsInfo(svc.store, container.LayerID)
+	if err != nil {
+		t.Fatalf("failed to get usage of container: %v", err)
+	}
+	if usage.LimitBytes == nil || usage.LimitBytes.Value != 1<<20 || usage.UsedBytes.Value != 0 {
+		t.Errorf("got limit %v, used %d bytes of new container, want limit 1M, nothing used",
+			usage.LimitBytes, usage.UsedBytes.Value)
+	}
+
+	dir := filepath.Join(svc.store.GraphRoot(), "vfs", "dir", container.LayerID)
+	if err := ioutil.WriteFile(filepath.Join(dir, "small"), make([]byte, 512<<10), 0644); err != nil {
+		t.Fatalf("failed to write within the limit: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "large"), make([]byte, 1<<20), 0644); err == nil {
+		t.Errorf("write above the limit should fail")
+	}
+
+	if err := svc.store.SetLayerQuota(container.LayerID, 4<<20); err != nil {
+		t.Fatalf("failed to change the limit: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "large"), make([]byte, 1<<20), 0644); err != nil {
+		t.Errorf("failed to write within the new limit: %v", err)
+	}
+	usage, err = getContainerStorageFsInfo(svc.store, container.LayerID)
+	if err != nil || usage.LimitBytes == nil || usage.LimitBytes.Value != 4<<20 {
+		t.Errorf("got usage %+v, %v, want limit 4M", usage, err)
+	}
+}
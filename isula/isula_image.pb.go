@@ -43,7 +43,7 @@ func (x Protocol) String() string {
 	return proto.EnumName(Protocol_name, int32(x))
 }
 func (Protocol) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{0}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{0}
 }
 
 type MountPropagation int32
@@ -73,7 +73,7 @@ func (x MountPropagation) String() string {
 	return proto.EnumName(MountPropagation_name, int32(x))
 }
 func (MountPropagation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{1}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{1}
 }
 
 type HealthCheckRequest struct {
@@ -86,7 +86,7 @@ func (m *HealthCheckRequest) Reset()         { *m = HealthCheckRequest{} }
 func (m *HealthCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*HealthCheckRequest) ProtoMessage()    {}
 func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{0}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{0}
 }
 func (m *HealthCheckRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HealthCheckRequest.Unmarshal(m, b)
@@ -118,7 +118,7 @@ func (m *HealthCheckResponse) Reset()         { *m = HealthCheckResponse{} }
 func (m *HealthCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*HealthCheckResponse) ProtoMessage()    {}
 func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{1}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{1}
 }
 func (m *HealthCheckResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HealthCheckResponse.Unmarshal(m, b)
@@ -153,9 +153,16 @@ func (m *HealthCheckResponse) GetCc() uint32 {
 }
 
 type LoginRequest struct {
-	Server               string   `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
-	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Server   string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// OAuth2 refresh token issued by registry, it is exchanged for access
+	// tokens when pulling. Used instead of password.
+	IdentityToken string `protobuf:"bytes,4,opt,name=identity_token,json=identityToken,proto3" json:"identity_token,omitempty"`
+	// Alias of identity_token
+	RefreshToken string `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Bearer token sent to registry as is. Used instead of password.
+	RegistryToken        string   `protobuf:"bytes,6,opt,name=registry_token,json=registryToken,proto3" json:"registry_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -165,7 +172,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{2}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{2}
 }
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
@@ -206,6 +213,27 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (m *LoginRequest) GetIdentityToken() string {
+	if m != nil {
+		return m.IdentityToken
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *LoginRequest) GetRegistryToken() string {
+	if m != nil {
+		return m.RegistryToken
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
 	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
@@ -218,7 +246,7 @@ func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
 func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
 func (*LoginResponse) ProtoMessage()    {}
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{3}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{3}
 }
 func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
@@ -263,7 +291,7 @@ func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
 func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
 func (*LogoutRequest) ProtoMessage()    {}
 func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{4}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{4}
 }
 func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
@@ -302,7 +330,7 @@ func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
 func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
 func (*LogoutResponse) ProtoMessage()    {}
 func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{5}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{5}
 }
 func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
@@ -336,6 +364,306 @@ func (m *LogoutResponse) GetCc() uint32 {
 	return 0
 }
 
+type LoginInfo struct {
+	Server   string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// credential store the credentials saved in, file, keyring or name of credential helper
+	Store                string   `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoginInfo) Reset()         { *m = LoginInfo{} }
+func (m *LoginInfo) String() string { return proto.CompactTextString(m) }
+func (*LoginInfo) ProtoMessage()    {}
+func (*LoginInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{6}
+}
+func (m *LoginInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginInfo.Unmarshal(m, b)
+}
+func (m *LoginInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginInfo.Marshal(b, m, deterministic)
+}
+func (dst *LoginInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginInfo.Merge(dst, src)
+}
+func (m *LoginInfo) XXX_Size() int {
+	return xxx_messageInfo_LoginInfo.Size(m)
+}
+func (m *LoginInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginInfo proto.InternalMessageInfo
+
+func (m *LoginInfo) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *LoginInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *LoginInfo) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+type ListLoginsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLoginsRequest) Reset()         { *m = ListLoginsRequest{} }
+func (m *ListLoginsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLoginsRequest) ProtoMessage()    {}
+func (*ListLoginsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{7}
+}
+func (m *ListLoginsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoginsRequest.Unmarshal(m, b)
+}
+func (m *ListLoginsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoginsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListLoginsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoginsRequest.Merge(dst, src)
+}
+func (m *ListLoginsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLoginsRequest.Size(m)
+}
+func (m *ListLoginsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoginsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoginsRequest proto.InternalMessageInfo
+
+type ListLoginsResponse struct {
+	Logins               []*LoginInfo `protobuf:"bytes,1,rep,name=logins,proto3" json:"logins,omitempty"`
+	Errmsg               string       `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32       `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListLoginsResponse) Reset()         { *m = ListLoginsResponse{} }
+func (m *ListLoginsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLoginsResponse) ProtoMessage()    {}
+func (*ListLoginsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{8}
+}
+func (m *ListLoginsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLoginsResponse.Unmarshal(m, b)
+}
+func (m *ListLoginsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLoginsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListLoginsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLoginsResponse.Merge(dst, src)
+}
+func (m *ListLoginsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLoginsResponse.Size(m)
+}
+func (m *ListLoginsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLoginsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLoginsResponse proto.InternalMessageInfo
+
+func (m *ListLoginsResponse) GetLogins() []*LoginInfo {
+	if m != nil {
+		return m.Logins
+	}
+	return nil
+}
+
+func (m *ListLoginsResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *ListLoginsResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+type LogoutAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutAllRequest) Reset()         { *m = LogoutAllRequest{} }
+func (m *LogoutAllRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutAllRequest) ProtoMessage()    {}
+func (*LogoutAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{9}
+}
+func (m *LogoutAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutAllRequest.Unmarshal(m, b)
+}
+func (m *LogoutAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutAllRequest.Marshal(b, m, deterministic)
+}
+func (dst *LogoutAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutAllRequest.Merge(dst, src)
+}
+func (m *LogoutAllRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutAllRequest.Size(m)
+}
+func (m *LogoutAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutAllRequest proto.InternalMessageInfo
+
+type LogoutAllResponse struct {
+	// registries logged out
+	Servers              []string `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutAllResponse) Reset()         { *m = LogoutAllResponse{} }
+func (m *LogoutAllResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutAllResponse) ProtoMessage()    {}
+func (*LogoutAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{10}
+}
+func (m *LogoutAllResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutAllResponse.Unmarshal(m, b)
+}
+func (m *LogoutAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutAllResponse.Marshal(b, m, deterministic)
+}
+func (dst *LogoutAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutAllResponse.Merge(dst, src)
+}
+func (m *LogoutAllResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutAllResponse.Size(m)
+}
+func (m *LogoutAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutAllResponse proto.InternalMessageInfo
+
+func (m *LogoutAllResponse) GetServers() []string {
+	if m != nil {
+		return m.Servers
+	}
+	return nil
+}
+
+func (m *LogoutAllResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *LogoutAllResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+type RotateAuthKeyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateAuthKeyRequest) Reset()         { *m = RotateAuthKeyRequest{} }
+func (m *RotateAuthKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateAuthKeyRequest) ProtoMessage()    {}
+func (*RotateAuthKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{11}
+}
+func (m *RotateAuthKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateAuthKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateAuthKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateAuthKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RotateAuthKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateAuthKeyRequest.Merge(dst, src)
+}
+func (m *RotateAuthKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateAuthKeyRequest.Size(m)
+}
+func (m *RotateAuthKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateAuthKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateAuthKeyRequest proto.InternalMessageInfo
+
+type RotateAuthKeyResponse struct {
+	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateAuthKeyResponse) Reset()         { *m = RotateAuthKeyResponse{} }
+func (m *RotateAuthKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateAuthKeyResponse) ProtoMessage()    {}
+func (*RotateAuthKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{12}
+}
+func (m *RotateAuthKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateAuthKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateAuthKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateAuthKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RotateAuthKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateAuthKeyResponse.Merge(dst, src)
+}
+func (m *RotateAuthKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateAuthKeyResponse.Size(m)
+}
+func (m *RotateAuthKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateAuthKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateAuthKeyResponse proto.InternalMessageInfo
+
+func (m *RotateAuthKeyResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *RotateAuthKeyResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
 type ContainerExportRequest struct {
 	NameId               string   `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
 	Output               string   `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
@@ -351,7 +679,7 @@ func (m *ContainerExportRequest) Reset()         { *m = ContainerExportRequest{}
 func (m *ContainerExportRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerExportRequest) ProtoMessage()    {}
 func (*ContainerExportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{6}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{13}
 }
 func (m *ContainerExportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerExportRequest.Unmarshal(m, b)
@@ -418,7 +746,7 @@ func (m *ContainerExportResponse) Reset()         { *m = ContainerExportResponse
 func (m *ContainerExportResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerExportResponse) ProtoMessage()    {}
 func (*ContainerExportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{7}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{14}
 }
 func (m *ContainerExportResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerExportResponse.Unmarshal(m, b)
@@ -464,7 +792,7 @@ func (m *LoadImageRequest) Reset()         { *m = LoadImageRequest{} }
 func (m *LoadImageRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadImageRequest) ProtoMessage()    {}
 func (*LoadImageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{8}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{15}
 }
 func (m *LoadImageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadImageRequest.Unmarshal(m, b)
@@ -511,7 +839,7 @@ func (m *LoadImageResponose) Reset()         { *m = LoadImageResponose{} }
 func (m *LoadImageResponose) String() string { return proto.CompactTextString(m) }
 func (*LoadImageResponose) ProtoMessage()    {}
 func (*LoadImageResponose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{9}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{16}
 }
 func (m *LoadImageResponose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadImageResponose.Unmarshal(m, b)
@@ -564,7 +892,7 @@ func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
 func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()    {}
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{10}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{17}
 }
 func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
@@ -611,7 +939,7 @@ func (m *ImportResponose) Reset()         { *m = ImportResponose{} }
 func (m *ImportResponose) String() string { return proto.CompactTextString(m) }
 func (*ImportResponose) ProtoMessage()    {}
 func (*ImportResponose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{11}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{18}
 }
 func (m *ImportResponose) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportResponose.Unmarshal(m, b)
@@ -662,7 +990,7 @@ func (m *GraphdriverStatusRequest) Reset()         { *m = GraphdriverStatusReque
 func (m *GraphdriverStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GraphdriverStatusRequest) ProtoMessage()    {}
 func (*GraphdriverStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{12}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{19}
 }
 func (m *GraphdriverStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GraphdriverStatusRequest.Unmarshal(m, b)
@@ -682,119 +1010,382 @@ func (m *GraphdriverStatusRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GraphdriverStatusRequest proto.InternalMessageInfo
 
-type GraphdriverStatusResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+type OverlayStatus struct {
+	SupportsDType        bool     `protobuf:"varint,1,opt,name=supports_d_type,json=supportsDType,proto3" json:"supports_d_type,omitempty"`
+	NativeDiff           bool     `protobuf:"varint,2,opt,name=native_diff,json=nativeDiff,proto3" json:"native_diff,omitempty"`
+	ProjectQuota         bool     `protobuf:"varint,3,opt,name=project_quota,json=projectQuota,proto3" json:"project_quota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GraphdriverStatusResponse) Reset()         { *m = GraphdriverStatusResponse{} }
-func (m *GraphdriverStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GraphdriverStatusResponse) ProtoMessage()    {}
-func (*GraphdriverStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{13}
+func (m *OverlayStatus) Reset()         { *m = OverlayStatus{} }
+func (m *OverlayStatus) String() string { return proto.CompactTextString(m) }
+func (*OverlayStatus) ProtoMessage()    {}
+func (*OverlayStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{20}
 }
-func (m *GraphdriverStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphdriverStatusResponse.Unmarshal(m, b)
+func (m *OverlayStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverlayStatus.Unmarshal(m, b)
 }
-func (m *GraphdriverStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphdriverStatusResponse.Marshal(b, m, deterministic)
+func (m *OverlayStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverlayStatus.Marshal(b, m, deterministic)
 }
-func (dst *GraphdriverStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphdriverStatusResponse.Merge(dst, src)
+func (dst *OverlayStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverlayStatus.Merge(dst, src)
 }
-func (m *GraphdriverStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GraphdriverStatusResponse.Size(m)
+func (m *OverlayStatus) XXX_Size() int {
+	return xxx_messageInfo_OverlayStatus.Size(m)
 }
-func (m *GraphdriverStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphdriverStatusResponse.DiscardUnknown(m)
+func (m *OverlayStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverlayStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GraphdriverStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_OverlayStatus proto.InternalMessageInfo
 
-func (m *GraphdriverStatusResponse) GetStatus() string {
+func (m *OverlayStatus) GetSupportsDType() bool {
 	if m != nil {
-		return m.Status
+		return m.SupportsDType
 	}
-	return ""
+	return false
 }
 
-func (m *GraphdriverStatusResponse) GetErrmsg() string {
+func (m *OverlayStatus) GetNativeDiff() bool {
 	if m != nil {
-		return m.Errmsg
+		return m.NativeDiff
 	}
-	return ""
+	return false
 }
 
-func (m *GraphdriverStatusResponse) GetCc() uint32 {
+func (m *OverlayStatus) GetProjectQuota() bool {
 	if m != nil {
-		return m.Cc
+		return m.ProjectQuota
 	}
-	return 0
+	return false
 }
 
-type GraphdriverMetadataRequest struct {
-	NameId               string   `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// sizes are in bytes
+type DevicemapperStatus struct {
+	PoolName               string   `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	DataFile               string   `protobuf:"bytes,2,opt,name=data_file,json=dataFile,proto3" json:"data_file,omitempty"`
+	MetadataFile           string   `protobuf:"bytes,3,opt,name=metadata_file,json=metadataFile,proto3" json:"metadata_file,omitempty"`
+	DataLoopFile           string   `protobuf:"bytes,4,opt,name=data_loop_file,json=dataLoopFile,proto3" json:"data_loop_file,omitempty"`
+	MetadataLoopFile       string   `protobuf:"bytes,5,opt,name=metadata_loop_file,json=metadataLoopFile,proto3" json:"metadata_loop_file,omitempty"`
+	DataUsed               uint64   `protobuf:"varint,6,opt,name=data_used,json=dataUsed,proto3" json:"data_used,omitempty"`
+	DataTotal              uint64   `protobuf:"varint,7,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	DataAvailable          uint64   `protobuf:"varint,8,opt,name=data_available,json=dataAvailable,proto3" json:"data_available,omitempty"`
+	MetadataUsed           uint64   `protobuf:"varint,9,opt,name=metadata_used,json=metadataUsed,proto3" json:"metadata_used,omitempty"`
+	MetadataTotal          uint64   `protobuf:"varint,10,opt,name=metadata_total,json=metadataTotal,proto3" json:"metadata_total,omitempty"`
+	MetadataAvailable      uint64   `protobuf:"varint,11,opt,name=metadata_available,json=metadataAvailable,proto3" json:"metadata_available,omitempty"`
+	MinFreeSpace           uint64   `protobuf:"varint,12,opt,name=min_free_space,json=minFreeSpace,proto3" json:"min_free_space,omitempty"`
+	BaseDeviceSize         uint64   `protobuf:"varint,13,opt,name=base_device_size,json=baseDeviceSize,proto3" json:"base_device_size,omitempty"`
+	UdevSyncSupported      bool     `protobuf:"varint,14,opt,name=udev_sync_supported,json=udevSyncSupported,proto3" json:"udev_sync_supported,omitempty"`
+	DeferredRemoval        bool     `protobuf:"varint,15,opt,name=deferred_removal,json=deferredRemoval,proto3" json:"deferred_removal,omitempty"`
+	DeferredDeletion       bool     `protobuf:"varint,16,opt,name=deferred_deletion,json=deferredDeletion,proto3" json:"deferred_deletion,omitempty"`
+	DeferredDeletedDevices uint32   `protobuf:"varint,17,opt,name=deferred_deleted_devices,json=deferredDeletedDevices,proto3" json:"deferred_deleted_devices,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
-func (m *GraphdriverMetadataRequest) Reset()         { *m = GraphdriverMetadataRequest{} }
-func (m *GraphdriverMetadataRequest) String() string { return proto.CompactTextString(m) }
-func (*GraphdriverMetadataRequest) ProtoMessage()    {}
-func (*GraphdriverMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{14}
+func (m *DevicemapperStatus) Reset()         { *m = DevicemapperStatus{} }
+func (m *DevicemapperStatus) String() string { return proto.CompactTextString(m) }
+func (*DevicemapperStatus) ProtoMessage()    {}
+func (*DevicemapperStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{21}
 }
-func (m *GraphdriverMetadataRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphdriverMetadataRequest.Unmarshal(m, b)
+func (m *DevicemapperStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevicemapperStatus.Unmarshal(m, b)
 }
-func (m *GraphdriverMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphdriverMetadataRequest.Marshal(b, m, deterministic)
+func (m *DevicemapperStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevicemapperStatus.Marshal(b, m, deterministic)
 }
-func (dst *GraphdriverMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphdriverMetadataRequest.Merge(dst, src)
+func (dst *DevicemapperStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevicemapperStatus.Merge(dst, src)
 }
-func (m *GraphdriverMetadataRequest) XXX_Size() int {
-	return xxx_messageInfo_GraphdriverMetadataRequest.Size(m)
+func (m *DevicemapperStatus) XXX_Size() int {
+	return xxx_messageInfo_DevicemapperStatus.Size(m)
 }
-func (m *GraphdriverMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphdriverMetadataRequest.DiscardUnknown(m)
+func (m *DevicemapperStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevicemapperStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GraphdriverMetadataRequest proto.InternalMessageInfo
+var xxx_messageInfo_DevicemapperStatus proto.InternalMessageInfo
 
-func (m *GraphdriverMetadataRequest) GetNameId() string {
+func (m *DevicemapperStatus) GetPoolName() string {
 	if m != nil {
-		return m.NameId
+		return m.PoolName
 	}
 	return ""
 }
 
-type GraphdriverMetadataResponse struct {
-	Metadata             map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Errmsg               string            `protobuf:"bytes,3,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32            `protobuf:"varint,4,opt,name=cc,proto3" json:"cc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DevicemapperStatus) GetDataFile() string {
+	if m != nil {
+		return m.DataFile
+	}
+	return ""
 }
 
-func (m *GraphdriverMetadataResponse) Reset()         { *m = GraphdriverMetadataResponse{} }
-func (m *GraphdriverMetadataResponse) String() string { return proto.CompactTextString(m) }
-func (*GraphdriverMetadataResponse) ProtoMessage()    {}
-func (*GraphdriverMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{15}
+func (m *DevicemapperStatus) GetMetadataFile() string {
+	if m != nil {
+		return m.MetadataFile
+	}
+	return ""
 }
-func (m *GraphdriverMetadataResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphdriverMetadataResponse.Unmarshal(m, b)
+
+func (m *DevicemapperStatus) GetDataLoopFile() string {
+	if m != nil {
+		return m.DataLoopFile
+	}
+	return ""
 }
-func (m *GraphdriverMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphdriverMetadataResponse.Marshal(b, m, deterministic)
+
+func (m *DevicemapperStatus) GetMetadataLoopFile() string {
+	if m != nil {
+		return m.MetadataLoopFile
+	}
+	return ""
+}
+
+func (m *DevicemapperStatus) GetDataUsed() uint64 {
+	if m != nil {
+		return m.DataUsed
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetDataTotal() uint64 {
+	if m != nil {
+		return m.DataTotal
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetDataAvailable() uint64 {
+	if m != nil {
+		return m.DataAvailable
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetMetadataUsed() uint64 {
+	if m != nil {
+		return m.MetadataUsed
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetMetadataTotal() uint64 {
+	if m != nil {
+		return m.MetadataTotal
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetMetadataAvailable() uint64 {
+	if m != nil {
+		return m.MetadataAvailable
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetMinFreeSpace() uint64 {
+	if m != nil {
+		return m.MinFreeSpace
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetBaseDeviceSize() uint64 {
+	if m != nil {
+		return m.BaseDeviceSize
+	}
+	return 0
+}
+
+func (m *DevicemapperStatus) GetUdevSyncSupported() bool {
+	if m != nil {
+		return m.UdevSyncSupported
+	}
+	return false
+}
+
+func (m *DevicemapperStatus) GetDeferredRemoval() bool {
+	if m != nil {
+		return m.DeferredRemoval
+	}
+	return false
+}
+
+func (m *DevicemapperStatus) GetDeferredDeletion() bool {
+	if m != nil {
+		return m.DeferredDeletion
+	}
+	return false
+}
+
+func (m *DevicemapperStatus) GetDeferredDeletedDevices() uint32 {
+	if m != nil {
+		return m.DeferredDeletedDevices
+	}
+	return 0
+}
+
+type GraphdriverStatusResponse struct {
+	// status pairs of the driver in "key: value" lines
+	Status            string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Errmsg            string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                uint32 `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	DriverName        string `protobuf:"bytes,4,opt,name=driver_name,json=driverName,proto3" json:"driver_name,omitempty"`
+	BackingFilesystem string `protobuf:"bytes,5,opt,name=backing_filesystem,json=backingFilesystem,proto3" json:"backing_filesystem,omitempty"`
+	// only the status of the driver in use is set
+	Overlay              *OverlayStatus      `protobuf:"bytes,6,opt,name=overlay,proto3" json:"overlay,omitempty"`
+	Devicemapper         *DevicemapperStatus `protobuf:"bytes,7,opt,name=devicemapper,proto3" json:"devicemapper,omitempty"`
+	Warnings             []string            `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GraphdriverStatusResponse) Reset()         { *m = GraphdriverStatusResponse{} }
+func (m *GraphdriverStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GraphdriverStatusResponse) ProtoMessage()    {}
+func (*GraphdriverStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{22}
+}
+func (m *GraphdriverStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphdriverStatusResponse.Unmarshal(m, b)
+}
+func (m *GraphdriverStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphdriverStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *GraphdriverStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphdriverStatusResponse.Merge(dst, src)
+}
+func (m *GraphdriverStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GraphdriverStatusResponse.Size(m)
+}
+func (m *GraphdriverStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphdriverStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphdriverStatusResponse proto.InternalMessageInfo
+
+func (m *GraphdriverStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GraphdriverStatusResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *GraphdriverStatusResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+func (m *GraphdriverStatusResponse) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *GraphdriverStatusResponse) GetBackingFilesystem() string {
+	if m != nil {
+		return m.BackingFilesystem
+	}
+	return ""
+}
+
+func (m *GraphdriverStatusResponse) GetOverlay() *OverlayStatus {
+	if m != nil {
+		return m.Overlay
+	}
+	return nil
+}
+
+func (m *GraphdriverStatusResponse) GetDevicemapper() *DevicemapperStatus {
+	if m != nil {
+		return m.Devicemapper
+	}
+	return nil
+}
+
+func (m *GraphdriverStatusResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type GraphdriverMetadataRequest struct {
+	NameId               string   `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphdriverMetadataRequest) Reset()         { *m = GraphdriverMetadataRequest{} }
+func (m *GraphdriverMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*GraphdriverMetadataRequest) ProtoMessage()    {}
+func (*GraphdriverMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{23}
+}
+func (m *GraphdriverMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphdriverMetadataRequest.Unmarshal(m, b)
+}
+func (m *GraphdriverMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphdriverMetadataRequest.Marshal(b, m, deterministic)
+}
+func (dst *GraphdriverMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphdriverMetadataRequest.Merge(dst, src)
+}
+func (m *GraphdriverMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_GraphdriverMetadataRequest.Size(m)
+}
+func (m *GraphdriverMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphdriverMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphdriverMetadataRequest proto.InternalMessageInfo
+
+func (m *GraphdriverMetadataRequest) GetNameId() string {
+	if m != nil {
+		return m.NameId
+	}
+	return ""
+}
+
+type GraphdriverMetadataResponse struct {
+	Metadata             map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Errmsg               string            `protobuf:"bytes,3,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32            `protobuf:"varint,4,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GraphdriverMetadataResponse) Reset()         { *m = GraphdriverMetadataResponse{} }
+func (m *GraphdriverMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*GraphdriverMetadataResponse) ProtoMessage()    {}
+func (*GraphdriverMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{24}
+}
+func (m *GraphdriverMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphdriverMetadataResponse.Unmarshal(m, b)
+}
+func (m *GraphdriverMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphdriverMetadataResponse.Marshal(b, m, deterministic)
 }
 func (dst *GraphdriverMetadataResponse) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_GraphdriverMetadataResponse.Merge(dst, src)
@@ -847,7 +1438,7 @@ func (m *ContainerFsUsageRequest) Reset()         { *m = ContainerFsUsageRequest
 func (m *ContainerFsUsageRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerFsUsageRequest) ProtoMessage()    {}
 func (*ContainerFsUsageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{16}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{25}
 }
 func (m *ContainerFsUsageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerFsUsageRequest.Unmarshal(m, b)
@@ -887,7 +1478,7 @@ func (m *ContainerFsUsageResponse) Reset()         { *m = ContainerFsUsageRespon
 func (m *ContainerFsUsageResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerFsUsageResponse) ProtoMessage()    {}
 func (*ContainerFsUsageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{17}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{26}
 }
 func (m *ContainerFsUsageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerFsUsageResponse.Unmarshal(m, b)
@@ -928,92 +1519,209 @@ func (m *ContainerFsUsageResponse) GetCc() uint32 {
 	return 0
 }
 
-type ContainerUmountRequest struct {
-	NameId               string   `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
-	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+type ContainerSetQuotaRequest struct {
+	NameId string `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
+	// New size limit, in the format of the size storage option, e.g. 10G
+	Size                 string   `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContainerUmountRequest) Reset()         { *m = ContainerUmountRequest{} }
-func (m *ContainerUmountRequest) String() string { return proto.CompactTextString(m) }
-func (*ContainerUmountRequest) ProtoMessage()    {}
-func (*ContainerUmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{18}
+func (m *ContainerSetQuotaRequest) Reset()         { *m = ContainerSetQuotaRequest{} }
+func (m *ContainerSetQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*ContainerSetQuotaRequest) ProtoMessage()    {}
+func (*ContainerSetQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{27}
 }
-func (m *ContainerUmountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContainerUmountRequest.Unmarshal(m, b)
+func (m *ContainerSetQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerSetQuotaRequest.Unmarshal(m, b)
 }
-func (m *ContainerUmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContainerUmountRequest.Marshal(b, m, deterministic)
+func (m *ContainerSetQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerSetQuotaRequest.Marshal(b, m, deterministic)
 }
-func (dst *ContainerUmountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerUmountRequest.Merge(dst, src)
+func (dst *ContainerSetQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetQuotaRequest.Merge(dst, src)
 }
-func (m *ContainerUmountRequest) XXX_Size() int {
-	return xxx_messageInfo_ContainerUmountRequest.Size(m)
+func (m *ContainerSetQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_ContainerSetQuotaRequest.Size(m)
 }
-func (m *ContainerUmountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerUmountRequest.DiscardUnknown(m)
+func (m *ContainerSetQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetQuotaRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerUmountRequest proto.InternalMessageInfo
+var xxx_messageInfo_ContainerSetQuotaRequest proto.InternalMessageInfo
 
-func (m *ContainerUmountRequest) GetNameId() string {
+func (m *ContainerSetQuotaRequest) GetNameId() string {
 	if m != nil {
 		return m.NameId
 	}
 	return ""
 }
 
-func (m *ContainerUmountRequest) GetForce() bool {
+func (m *ContainerSetQuotaRequest) GetSize() string {
 	if m != nil {
-		return m.Force
+		return m.Size
 	}
-	return false
+	return ""
 }
 
-type ContainerUmountResponse struct {
-	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
+type ContainerSetQuotaResponse struct {
+	LimitBytes           uint64   `protobuf:"varint,1,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	UsedBytes            uint64   `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	InodesUsed           uint64   `protobuf:"varint,3,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
+	Errmsg               string   `protobuf:"bytes,4,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,5,opt,name=cc,proto3" json:"cc,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContainerUmountResponse) Reset()         { *m = ContainerUmountResponse{} }
-func (m *ContainerUmountResponse) String() string { return proto.CompactTextString(m) }
-func (*ContainerUmountResponse) ProtoMessage()    {}
-func (*ContainerUmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{19}
+func (m *ContainerSetQuotaResponse) Reset()         { *m = ContainerSetQuotaResponse{} }
+func (m *ContainerSetQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*ContainerSetQuotaResponse) ProtoMessage()    {}
+func (*ContainerSetQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{28}
 }
-func (m *ContainerUmountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContainerUmountResponse.Unmarshal(m, b)
+func (m *ContainerSetQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerSetQuotaResponse.Unmarshal(m, b)
 }
-func (m *ContainerUmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContainerUmountResponse.Marshal(b, m, deterministic)
+func (m *ContainerSetQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerSetQuotaResponse.Marshal(b, m, deterministic)
 }
-func (dst *ContainerUmountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerUmountResponse.Merge(dst, src)
+func (dst *ContainerSetQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetQuotaResponse.Merge(dst, src)
 }
-func (m *ContainerUmountResponse) XXX_Size() int {
-	return xxx_messageInfo_ContainerUmountResponse.Size(m)
+func (m *ContainerSetQuotaResponse) XXX_Size() int {
+	return xxx_messageInfo_ContainerSetQuotaResponse.Size(m)
 }
-func (m *ContainerUmountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerUmountResponse.DiscardUnknown(m)
+func (m *ContainerSetQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetQuotaResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerUmountResponse proto.InternalMessageInfo
+var xxx_messageInfo_ContainerSetQuotaResponse proto.InternalMessageInfo
 
-func (m *ContainerUmountResponse) GetErrmsg() string {
+func (m *ContainerSetQuotaResponse) GetLimitBytes() uint64 {
+	if m != nil {
+		return m.LimitBytes
+	}
+	return 0
+}
+
+func (m *ContainerSetQuotaResponse) GetUsedBytes() uint64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *ContainerSetQuotaResponse) GetInodesUsed() uint64 {
+	if m != nil {
+		return m.InodesUsed
+	}
+	return 0
+}
+
+func (m *ContainerSetQuotaResponse) GetErrmsg() string {
 	if m != nil {
 		return m.Errmsg
 	}
 	return ""
 }
 
-func (m *ContainerUmountResponse) GetCc() uint32 {
+func (m *ContainerSetQuotaResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+type ContainerUmountRequest struct {
+	NameId               string   `protobuf:"bytes,1,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerUmountRequest) Reset()         { *m = ContainerUmountRequest{} }
+func (m *ContainerUmountRequest) String() string { return proto.CompactTextString(m) }
+func (*ContainerUmountRequest) ProtoMessage()    {}
+func (*ContainerUmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{29}
+}
+func (m *ContainerUmountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerUmountRequest.Unmarshal(m, b)
+}
+func (m *ContainerUmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerUmountRequest.Marshal(b, m, deterministic)
+}
+func (dst *ContainerUmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerUmountRequest.Merge(dst, src)
+}
+func (m *ContainerUmountRequest) XXX_Size() int {
+	return xxx_messageInfo_ContainerUmountRequest.Size(m)
+}
+func (m *ContainerUmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerUmountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerUmountRequest proto.InternalMessageInfo
+
+func (m *ContainerUmountRequest) GetNameId() string {
+	if m != nil {
+		return m.NameId
+	}
+	return ""
+}
+
+func (m *ContainerUmountRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ContainerUmountResponse struct {
+	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerUmountResponse) Reset()         { *m = ContainerUmountResponse{} }
+func (m *ContainerUmountResponse) String() string { return proto.CompactTextString(m) }
+func (*ContainerUmountResponse) ProtoMessage()    {}
+func (*ContainerUmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{30}
+}
+func (m *ContainerUmountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerUmountResponse.Unmarshal(m, b)
+}
+func (m *ContainerUmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerUmountResponse.Marshal(b, m, deterministic)
+}
+func (dst *ContainerUmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerUmountResponse.Merge(dst, src)
+}
+func (m *ContainerUmountResponse) XXX_Size() int {
+	return xxx_messageInfo_ContainerUmountResponse.Size(m)
+}
+func (m *ContainerUmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerUmountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerUmountResponse proto.InternalMessageInfo
+
+func (m *ContainerUmountResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *ContainerUmountResponse) GetCc() uint32 {
 	if m != nil {
 		return m.Cc
 	}
@@ -1031,7 +1739,7 @@ func (m *ContainerMountRequest) Reset()         { *m = ContainerMountRequest{} }
 func (m *ContainerMountRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerMountRequest) ProtoMessage()    {}
 func (*ContainerMountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{20}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{31}
 }
 func (m *ContainerMountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerMountRequest.Unmarshal(m, b)
@@ -1070,7 +1778,7 @@ func (m *ContainerMountResponse) Reset()         { *m = ContainerMountResponse{}
 func (m *ContainerMountResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerMountResponse) ProtoMessage()    {}
 func (*ContainerMountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{21}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{32}
 }
 func (m *ContainerMountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerMountResponse.Unmarshal(m, b)
@@ -1115,7 +1823,7 @@ func (m *ContainerRemoveRequest) Reset()         { *m = ContainerRemoveRequest{}
 func (m *ContainerRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerRemoveRequest) ProtoMessage()    {}
 func (*ContainerRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{22}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{33}
 }
 func (m *ContainerRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerRemoveRequest.Unmarshal(m, b)
@@ -1154,7 +1862,7 @@ func (m *ContainerRemoveResponse) Reset()         { *m = ContainerRemoveResponse
 func (m *ContainerRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerRemoveResponse) ProtoMessage()    {}
 func (*ContainerRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{23}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{34}
 }
 func (m *ContainerRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerRemoveResponse.Unmarshal(m, b)
@@ -1188,11 +1896,246 @@ func (m *ContainerRemoveResponse) GetCc() uint32 {
 	return 0
 }
 
+type RemovePodRequest struct {
+	PodId                string   `protobuf:"bytes,1,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePodRequest) Reset()         { *m = RemovePodRequest{} }
+func (m *RemovePodRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePodRequest) ProtoMessage()    {}
+func (*RemovePodRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{35}
+}
+func (m *RemovePodRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePodRequest.Unmarshal(m, b)
+}
+func (m *RemovePodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePodRequest.Marshal(b, m, deterministic)
+}
+func (dst *RemovePodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePodRequest.Merge(dst, src)
+}
+func (m *RemovePodRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePodRequest.Size(m)
+}
+func (m *RemovePodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePodRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePodRequest proto.InternalMessageInfo
+
+func (m *RemovePodRequest) GetPodId() string {
+	if m != nil {
+		return m.PodId
+	}
+	return ""
+}
+
+type RemovePodResponse struct {
+	// IDs of containers removed
+	Containers           []string `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePodResponse) Reset()         { *m = RemovePodResponse{} }
+func (m *RemovePodResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePodResponse) ProtoMessage()    {}
+func (*RemovePodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{36}
+}
+func (m *RemovePodResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePodResponse.Unmarshal(m, b)
+}
+func (m *RemovePodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePodResponse.Marshal(b, m, deterministic)
+}
+func (dst *RemovePodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePodResponse.Merge(dst, src)
+}
+func (m *RemovePodResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePodResponse.Size(m)
+}
+func (m *RemovePodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePodResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePodResponse proto.InternalMessageInfo
+
+func (m *RemovePodResponse) GetContainers() []string {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+func (m *RemovePodResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *RemovePodResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+// the pod a container belongs to, like PodSandboxMetadata of CRI
+type PodInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid                  string   `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Attempt              uint32   `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodInfo) Reset()         { *m = PodInfo{} }
+func (m *PodInfo) String() string { return proto.CompactTextString(m) }
+func (*PodInfo) ProtoMessage()    {}
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{37}
+}
+func (m *PodInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodInfo.Unmarshal(m, b)
+}
+func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodInfo.Marshal(b, m, deterministic)
+}
+func (dst *PodInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodInfo.Merge(dst, src)
+}
+func (m *PodInfo) XXX_Size() int {
+	return xxx_messageInfo_PodInfo.Size(m)
+}
+func (m *PodInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodInfo proto.InternalMessageInfo
+
+func (m *PodInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PodInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PodInfo) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PodInfo) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *PodInfo) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+// maps size IDs starting from container_id in user namespace of a container
+// to IDs starting from host_id on the host
+type IDMap struct {
+	ContainerId          uint32   `protobuf:"varint,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	HostId               uint32   `protobuf:"varint,2,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Size                 uint32   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDMap) Reset()         { *m = IDMap{} }
+func (m *IDMap) String() string { return proto.CompactTextString(m) }
+func (*IDMap) ProtoMessage()    {}
+func (*IDMap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{38}
+}
+func (m *IDMap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDMap.Unmarshal(m, b)
+}
+func (m *IDMap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDMap.Marshal(b, m, deterministic)
+}
+func (dst *IDMap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDMap.Merge(dst, src)
+}
+func (m *IDMap) XXX_Size() int {
+	return xxx_messageInfo_IDMap.Size(m)
+}
+func (m *IDMap) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDMap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDMap proto.InternalMessageInfo
+
+func (m *IDMap) GetContainerId() uint32 {
+	if m != nil {
+		return m.ContainerId
+	}
+	return 0
+}
+
+func (m *IDMap) GetHostId() uint32 {
+	if m != nil {
+		return m.HostId
+	}
+	return 0
+}
+
+func (m *IDMap) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 type ContainerPrepareRequest struct {
-	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	StorageOpts          []string `protobuf:"bytes,4,rep,name=storage_opts,json=storageOpts,proto3" json:"storage_opts,omitempty"`
+	Image       string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Id          string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StorageOpts []string `protobuf:"bytes,4,rep,name=storage_opts,json=storageOpts,proto3" json:"storage_opts,omitempty"`
+	// ID mappings of the rootfs for a container in user namespace, files
+	// in the rootfs are owned by the mapped IDs. Host IDs are used if not set.
+	UidMaps []*IDMap `protobuf:"bytes,5,rep,name=uid_maps,json=uidMaps,proto3" json:"uid_maps,omitempty"`
+	GidMaps []*IDMap `protobuf:"bytes,6,rep,name=gid_maps,json=gidMaps,proto3" json:"gid_maps,omitempty"`
+	// allocate ID mappings of auto_userns_size IDs, 65536 if 0, from
+	// subordinate IDs not used by other containers instead of uid_maps and gid_maps
+	AutoUserns     bool   `protobuf:"varint,7,opt,name=auto_userns,json=autoUserns,proto3" json:"auto_userns,omitempty"`
+	AutoUsernsSize uint32 `protobuf:"varint,8,opt,name=auto_userns_size,json=autoUsernsSize,proto3" json:"auto_userns_size,omitempty"`
+	// SELinux label mounting the rootfs with, a label with unique MCS level
+	// is generated if not set and SELinux is enabled. label_opts like
+	// "type:<type>" or "level:<level>" change parts of the generated label.
+	MountLabel string   `protobuf:"bytes,9,opt,name=mount_label,json=mountLabel,proto3" json:"mount_label,omitempty"`
+	LabelOpts  []string `protobuf:"bytes,10,rep,name=label_opts,json=labelOpts,proto3" json:"label_opts,omitempty"`
+	// pod of the container, not set if it does not belong to a pod
+	Pod                  *PodInfo `protobuf:"bytes,11,opt,name=pod,proto3" json:"pod,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1202,7 +2145,7 @@ func (m *ContainerPrepareRequest) Reset()         { *m = ContainerPrepareRequest
 func (m *ContainerPrepareRequest) String() string { return proto.CompactTextString(m) }
 func (*ContainerPrepareRequest) ProtoMessage()    {}
 func (*ContainerPrepareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{24}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{39}
 }
 func (m *ContainerPrepareRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerPrepareRequest.Unmarshal(m, b)
@@ -1250,11 +2193,67 @@ func (m *ContainerPrepareRequest) GetStorageOpts() []string {
 	return nil
 }
 
+func (m *ContainerPrepareRequest) GetUidMaps() []*IDMap {
+	if m != nil {
+		return m.UidMaps
+	}
+	return nil
+}
+
+func (m *ContainerPrepareRequest) GetGidMaps() []*IDMap {
+	if m != nil {
+		return m.GidMaps
+	}
+	return nil
+}
+
+func (m *ContainerPrepareRequest) GetAutoUserns() bool {
+	if m != nil {
+		return m.AutoUserns
+	}
+	return false
+}
+
+func (m *ContainerPrepareRequest) GetAutoUsernsSize() uint32 {
+	if m != nil {
+		return m.AutoUsernsSize
+	}
+	return 0
+}
+
+func (m *ContainerPrepareRequest) GetMountLabel() string {
+	if m != nil {
+		return m.MountLabel
+	}
+	return ""
+}
+
+func (m *ContainerPrepareRequest) GetLabelOpts() []string {
+	if m != nil {
+		return m.LabelOpts
+	}
+	return nil
+}
+
+func (m *ContainerPrepareRequest) GetPod() *PodInfo {
+	if m != nil {
+		return m.Pod
+	}
+	return nil
+}
+
 type ContainerPrepareResponse struct {
-	MountPoint           string   `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
-	ImageConf            string   `protobuf:"bytes,2,opt,name=image_conf,json=imageConf,proto3" json:"image_conf,omitempty"`
-	Errmsg               string   `protobuf:"bytes,3,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32   `protobuf:"varint,4,opt,name=cc,proto3" json:"cc,omitempty"`
+	MountPoint string `protobuf:"bytes,1,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	ImageConf  string `protobuf:"bytes,2,opt,name=image_conf,json=imageConf,proto3" json:"image_conf,omitempty"`
+	Errmsg     string `protobuf:"bytes,3,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc         uint32 `protobuf:"varint,4,opt,name=cc,proto3" json:"cc,omitempty"`
+	// ID mappings of the rootfs, empty if host IDs are used
+	UidMaps []*IDMap `protobuf:"bytes,5,rep,name=uid_maps,json=uidMaps,proto3" json:"uid_maps,omitempty"`
+	GidMaps []*IDMap `protobuf:"bytes,6,rep,name=gid_maps,json=gidMaps,proto3" json:"gid_maps,omitempty"`
+	// SELinux labels of the rootfs and processes of the container, empty
+	// if SELinux is disabled
+	MountLabel           string   `protobuf:"bytes,7,opt,name=mount_label,json=mountLabel,proto3" json:"mount_label,omitempty"`
+	ProcessLabel         string   `protobuf:"bytes,8,opt,name=process_label,json=processLabel,proto3" json:"process_label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1264,7 +2263,7 @@ func (m *ContainerPrepareResponse) Reset()         { *m = ContainerPrepareRespon
 func (m *ContainerPrepareResponse) String() string { return proto.CompactTextString(m) }
 func (*ContainerPrepareResponse) ProtoMessage()    {}
 func (*ContainerPrepareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{25}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{40}
 }
 func (m *ContainerPrepareResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContainerPrepareResponse.Unmarshal(m, b)
@@ -1312,17 +2311,47 @@ func (m *ContainerPrepareResponse) GetCc() uint32 {
 	return 0
 }
 
-type ListContainersRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
+func (m *ContainerPrepareResponse) GetUidMaps() []*IDMap {
+	if m != nil {
+		return m.UidMaps
+	}
+	return nil
+}
+
+func (m *ContainerPrepareResponse) GetGidMaps() []*IDMap {
+	if m != nil {
+		return m.GidMaps
+	}
+	return nil
+}
+
+func (m *ContainerPrepareResponse) GetMountLabel() string {
+	if m != nil {
+		return m.MountLabel
+	}
+	return ""
+}
+
+func (m *ContainerPrepareResponse) GetProcessLabel() string {
+	if m != nil {
+		return m.ProcessLabel
+	}
+	return ""
+}
+
+type ListContainersRequest struct {
+	// only list containers of the pod if set
+	PodId                string   `protobuf:"bytes,1,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
 func (m *ListContainersRequest) Reset()         { *m = ListContainersRequest{} }
 func (m *ListContainersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListContainersRequest) ProtoMessage()    {}
 func (*ListContainersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{26}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{41}
 }
 func (m *ListContainersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListContainersRequest.Unmarshal(m, b)
@@ -1342,20 +2371,132 @@ func (m *ListContainersRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListContainersRequest proto.InternalMessageInfo
 
+func (m *ListContainersRequest) GetPodId() string {
+	if m != nil {
+		return m.PodId
+	}
+	return ""
+}
+
+type ContainerInfo struct {
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Names     []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	ImageId   string   `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	ImageName string   `protobuf:"bytes,4,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	LayerId   string   `protobuf:"bytes,5,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	Mounted   bool     `protobuf:"varint,6,opt,name=mounted,proto3" json:"mounted,omitempty"`
+	// unix time in seconds
+	CreatedAt            int64    `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MountLabel           string   `protobuf:"bytes,8,opt,name=mount_label,json=mountLabel,proto3" json:"mount_label,omitempty"`
+	Pod                  *PodInfo `protobuf:"bytes,9,opt,name=pod,proto3" json:"pod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerInfo) Reset()         { *m = ContainerInfo{} }
+func (m *ContainerInfo) String() string { return proto.CompactTextString(m) }
+func (*ContainerInfo) ProtoMessage()    {}
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{42}
+}
+func (m *ContainerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerInfo.Unmarshal(m, b)
+}
+func (m *ContainerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerInfo.Marshal(b, m, deterministic)
+}
+func (dst *ContainerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerInfo.Merge(dst, src)
+}
+func (m *ContainerInfo) XXX_Size() int {
+	return xxx_messageInfo_ContainerInfo.Size(m)
+}
+func (m *ContainerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerInfo proto.InternalMessageInfo
+
+func (m *ContainerInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ContainerInfo) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetImageName() string {
+	if m != nil {
+		return m.ImageName
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetLayerId() string {
+	if m != nil {
+		return m.LayerId
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetMounted() bool {
+	if m != nil {
+		return m.Mounted
+	}
+	return false
+}
+
+func (m *ContainerInfo) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ContainerInfo) GetMountLabel() string {
+	if m != nil {
+		return m.MountLabel
+	}
+	return ""
+}
+
+func (m *ContainerInfo) GetPod() *PodInfo {
+	if m != nil {
+		return m.Pod
+	}
+	return nil
+}
+
 type ListContainersResponse struct {
-	Containers           map[string]bool `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Errmsg               string          `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32          `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	// whether containers are mounted, kept for old clients
+	Containers           map[string]bool  `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Errmsg               string           `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32           `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	Infos                []*ContainerInfo `protobuf:"bytes,4,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ListContainersResponse) Reset()         { *m = ListContainersResponse{} }
 func (m *ListContainersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListContainersResponse) ProtoMessage()    {}
 func (*ListContainersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{27}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{43}
 }
 func (m *ListContainersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListContainersResponse.Unmarshal(m, b)
@@ -1396,6 +2537,13 @@ func (m *ListContainersResponse) GetCc() uint32 {
 	return 0
 }
 
+func (m *ListContainersResponse) GetInfos() []*ContainerInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
 // DNSConfig specifies the DNS servers and search domains of a sandbox.
 type DNSConfig struct {
 	// List of DNS servers of the cluster.
@@ -1414,7 +2562,7 @@ func (m *DNSConfig) Reset()         { *m = DNSConfig{} }
 func (m *DNSConfig) String() string { return proto.CompactTextString(m) }
 func (*DNSConfig) ProtoMessage()    {}
 func (*DNSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{28}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{44}
 }
 func (m *DNSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DNSConfig.Unmarshal(m, b)
@@ -1474,7 +2622,7 @@ func (m *PortMapping) Reset()         { *m = PortMapping{} }
 func (m *PortMapping) String() string { return proto.CompactTextString(m) }
 func (*PortMapping) ProtoMessage()    {}
 func (*PortMapping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{29}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{45}
 }
 func (m *PortMapping) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PortMapping.Unmarshal(m, b)
@@ -1543,7 +2691,7 @@ func (m *Mount) Reset()         { *m = Mount{} }
 func (m *Mount) String() string { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()    {}
 func (*Mount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{30}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{46}
 }
 func (m *Mount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Mount.Unmarshal(m, b)
@@ -1615,7 +2763,7 @@ func (m *NamespaceOption) Reset()         { *m = NamespaceOption{} }
 func (m *NamespaceOption) String() string { return proto.CompactTextString(m) }
 func (*NamespaceOption) ProtoMessage()    {}
 func (*NamespaceOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{31}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{47}
 }
 func (m *NamespaceOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NamespaceOption.Unmarshal(m, b)
@@ -1669,7 +2817,7 @@ func (m *Int64Value) Reset()         { *m = Int64Value{} }
 func (m *Int64Value) String() string { return proto.CompactTextString(m) }
 func (*Int64Value) ProtoMessage()    {}
 func (*Int64Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{32}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{48}
 }
 func (m *Int64Value) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Int64Value.Unmarshal(m, b)
@@ -1711,7 +2859,7 @@ func (m *SELinuxOption) Reset()         { *m = SELinuxOption{} }
 func (m *SELinuxOption) String() string { return proto.CompactTextString(m) }
 func (*SELinuxOption) ProtoMessage()    {}
 func (*SELinuxOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{33}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{49}
 }
 func (m *SELinuxOption) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SELinuxOption.Unmarshal(m, b)
@@ -1799,7 +2947,7 @@ func (m *LinuxSandboxSecurityContext) Reset()         { *m = LinuxSandboxSecurit
 func (m *LinuxSandboxSecurityContext) String() string { return proto.CompactTextString(m) }
 func (*LinuxSandboxSecurityContext) ProtoMessage()    {}
 func (*LinuxSandboxSecurityContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{34}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{50}
 }
 func (m *LinuxSandboxSecurityContext) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LinuxSandboxSecurityContext.Unmarshal(m, b)
@@ -1888,7 +3036,7 @@ func (m *LinuxPodSandboxConfig) Reset()         { *m = LinuxPodSandboxConfig{} }
 func (m *LinuxPodSandboxConfig) String() string { return proto.CompactTextString(m) }
 func (*LinuxPodSandboxConfig) ProtoMessage()    {}
 func (*LinuxPodSandboxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{35}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{51}
 }
 func (m *LinuxPodSandboxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LinuxPodSandboxConfig.Unmarshal(m, b)
@@ -1951,7 +3099,7 @@ func (m *PodSandboxMetadata) Reset()         { *m = PodSandboxMetadata{} }
 func (m *PodSandboxMetadata) String() string { return proto.CompactTextString(m) }
 func (*PodSandboxMetadata) ProtoMessage()    {}
 func (*PodSandboxMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{36}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{52}
 }
 func (m *PodSandboxMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PodSandboxMetadata.Unmarshal(m, b)
@@ -2059,7 +3207,7 @@ func (m *PodSandboxConfig) Reset()         { *m = PodSandboxConfig{} }
 func (m *PodSandboxConfig) String() string { return proto.CompactTextString(m) }
 func (*PodSandboxConfig) ProtoMessage()    {}
 func (*PodSandboxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{37}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{53}
 }
 func (m *PodSandboxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PodSandboxConfig.Unmarshal(m, b)
@@ -2149,7 +3297,7 @@ func (m *ImageSpec) Reset()         { *m = ImageSpec{} }
 func (m *ImageSpec) String() string { return proto.CompactTextString(m) }
 func (*ImageSpec) ProtoMessage()    {}
 func (*ImageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{38}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{54}
 }
 func (m *ImageSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageSpec.Unmarshal(m, b)
@@ -2188,7 +3336,7 @@ func (m *ImageFilter) Reset()         { *m = ImageFilter{} }
 func (m *ImageFilter) String() string { return proto.CompactTextString(m) }
 func (*ImageFilter) ProtoMessage()    {}
 func (*ImageFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{39}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{55}
 }
 func (m *ImageFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageFilter.Unmarshal(m, b)
@@ -2217,18 +3365,26 @@ func (m *ImageFilter) GetImage() *ImageSpec {
 
 type ListImagesRequest struct {
 	// Filter to list images.
-	Filter               *ImageFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	Check                bool         `protobuf:"varint,2,opt,name=check,proto3" json:"check,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Filter *ImageFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Check  bool         `protobuf:"varint,2,opt,name=check,proto3" json:"check,omitempty"`
+	// Sort images by "id", "name", "created", "loaded" or "size", images
+	// are in store order if not set.
+	SortBy     string `protobuf:"bytes,3,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Descending bool   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Number of images to skip.
+	Offset uint32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of images to return, 0 means no limit.
+	Limit                uint32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListImagesRequest) Reset()         { *m = ListImagesRequest{} }
 func (m *ListImagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListImagesRequest) ProtoMessage()    {}
 func (*ListImagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{40}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{56}
 }
 func (m *ListImagesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListImagesRequest.Unmarshal(m, b)
@@ -2262,6 +3418,34 @@ func (m *ListImagesRequest) GetCheck() bool {
 	return false
 }
 
+func (m *ListImagesRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListImagesRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+func (m *ListImagesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListImagesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type HealthCheck struct {
 	Test                 []string `protobuf:"bytes,1,rep,name=test,proto3" json:"test,omitempty"`
 	Interval             int64    `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
@@ -2278,7 +3462,7 @@ func (m *HealthCheck) Reset()         { *m = HealthCheck{} }
 func (m *HealthCheck) String() string { return proto.CompactTextString(m) }
 func (*HealthCheck) ProtoMessage()    {}
 func (*HealthCheck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{41}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{57}
 }
 func (m *HealthCheck) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HealthCheck.Unmarshal(m, b)
@@ -2364,17 +3548,21 @@ type Image struct {
 	// oci image spec
 	Spec *ImageSpec `protobuf:"bytes,9,opt,name=spec,proto3" json:"spec,omitempty"`
 	// Health check
-	Healthcheck          *HealthCheck `protobuf:"bytes,10,opt,name=healthcheck,proto3" json:"healthcheck,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Healthcheck *HealthCheck `protobuf:"bytes,10,opt,name=healthcheck,proto3" json:"healthcheck,omitempty"`
+	// Last time a container was prepared from the image, empty if never
+	LastUsed string `protobuf:"bytes,11,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	// Pinned images can not be removed without force, nor be evicted
+	Pinned               bool     `protobuf:"varint,12,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Image) Reset()         { *m = Image{} }
 func (m *Image) String() string { return proto.CompactTextString(m) }
 func (*Image) ProtoMessage()    {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{42}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{58}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Image.Unmarshal(m, b)
@@ -2464,11 +3652,27 @@ func (m *Image) GetHealthcheck() *HealthCheck {
 	return nil
 }
 
+func (m *Image) GetLastUsed() string {
+	if m != nil {
+		return m.LastUsed
+	}
+	return ""
+}
+
+func (m *Image) GetPinned() bool {
+	if m != nil {
+		return m.Pinned
+	}
+	return false
+}
+
 type ListImagesResponse struct {
 	// List of images.
-	Images               []*Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
-	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	Images []*Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Errmsg string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc     uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	// Number of images before pagination.
+	Total                uint32   `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2478,7 +3682,7 @@ func (m *ListImagesResponse) Reset()         { *m = ListImagesResponse{} }
 func (m *ListImagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListImagesResponse) ProtoMessage()    {}
 func (*ListImagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{43}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{59}
 }
 func (m *ListImagesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListImagesResponse.Unmarshal(m, b)
@@ -2519,6 +3723,13 @@ func (m *ListImagesResponse) GetCc() uint32 {
 	return 0
 }
 
+func (m *ListImagesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 type ImageStatusRequest struct {
 	// Spec of the image.
 	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
@@ -2533,7 +3744,7 @@ func (m *ImageStatusRequest) Reset()         { *m = ImageStatusRequest{} }
 func (m *ImageStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*ImageStatusRequest) ProtoMessage()    {}
 func (*ImageStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{44}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{60}
 }
 func (m *ImageStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageStatusRequest.Unmarshal(m, b)
@@ -2586,7 +3797,7 @@ func (m *ImageStatusResponse) Reset()         { *m = ImageStatusResponse{} }
 func (m *ImageStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ImageStatusResponse) ProtoMessage()    {}
 func (*ImageStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{45}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{61}
 }
 func (m *ImageStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageStatusResponse.Unmarshal(m, b)
@@ -2645,7 +3856,7 @@ func (m *ImageInfoRequest) Reset()         { *m = ImageInfoRequest{} }
 func (m *ImageInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ImageInfoRequest) ProtoMessage()    {}
 func (*ImageInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{46}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{62}
 }
 func (m *ImageInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageInfoRequest.Unmarshal(m, b)
@@ -2685,7 +3896,7 @@ func (m *ImageInfoResponse) Reset()         { *m = ImageInfoResponse{} }
 func (m *ImageInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ImageInfoResponse) ProtoMessage()    {}
 func (*ImageInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{47}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{63}
 }
 func (m *ImageInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageInfoResponse.Unmarshal(m, b)
@@ -2746,7 +3957,7 @@ func (m *AuthConfig) Reset()         { *m = AuthConfig{} }
 func (m *AuthConfig) String() string { return proto.CompactTextString(m) }
 func (*AuthConfig) ProtoMessage()    {}
 func (*AuthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{48}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{64}
 }
 func (m *AuthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthConfig.Unmarshal(m, b)
@@ -2814,17 +4025,22 @@ type PullImageRequest struct {
 	// Authentication configuration for pulling the image.
 	Auth *AuthConfig `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
 	// Config of the PodSandbox, which is used to pull image in PodSandbox context.
-	SandboxConfig        *PodSandboxConfig `protobuf:"bytes,3,opt,name=sandbox_config,json=sandboxConfig,proto3" json:"sandbox_config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	SandboxConfig *PodSandboxConfig `protobuf:"bytes,3,opt,name=sandbox_config,json=sandboxConfig,proto3" json:"sandbox_config,omitempty"`
+	// Maximum bytes per second to download the image, 0 means no limit
+	// other than the daemon config.
+	MaxBytesPerSec int64 `protobuf:"varint,4,opt,name=max_bytes_per_sec,json=maxBytesPerSec,proto3" json:"max_bytes_per_sec,omitempty"`
+	// Maximum number of layers downloaded in parallel, 0 means use the daemon config.
+	MaxParallelDownloads uint32   `protobuf:"varint,5,opt,name=max_parallel_downloads,json=maxParallelDownloads,proto3" json:"max_parallel_downloads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PullImageRequest) Reset()         { *m = PullImageRequest{} }
 func (m *PullImageRequest) String() string { return proto.CompactTextString(m) }
 func (*PullImageRequest) ProtoMessage()    {}
 func (*PullImageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{49}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{65}
 }
 func (m *PullImageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PullImageRequest.Unmarshal(m, b)
@@ -2865,12 +4081,29 @@ func (m *PullImageRequest) GetSandboxConfig() *PodSandboxConfig {
 	return nil
 }
 
+func (m *PullImageRequest) GetMaxBytesPerSec() int64 {
+	if m != nil {
+		return m.MaxBytesPerSec
+	}
+	return 0
+}
+
+func (m *PullImageRequest) GetMaxParallelDownloads() uint32 {
+	if m != nil {
+		return m.MaxParallelDownloads
+	}
+	return 0
+}
+
 type PullImageResponse struct {
 	// Reference to the image in use. For most runtimes, this should be an
 	// image ID or digest.
-	ImageRef             string   `protobuf:"bytes,1,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
-	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32   `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	ImageRef string `protobuf:"bytes,1,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
+	Errmsg   string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc       uint32 `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	// Attempts made to pull the image, including retries on transient
+	// failures and attempts on other registries.
+	Attempts             uint32   `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2880,7 +4113,7 @@ func (m *PullImageResponse) Reset()         { *m = PullImageResponse{} }
 func (m *PullImageResponse) String() string { return proto.CompactTextString(m) }
 func (*PullImageResponse) ProtoMessage()    {}
 func (*PullImageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{50}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{66}
 }
 func (m *PullImageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PullImageResponse.Unmarshal(m, b)
@@ -2921,20 +4154,28 @@ func (m *PullImageResponse) GetCc() uint32 {
 	return 0
 }
 
+func (m *PullImageResponse) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 type RemoveImageRequest struct {
 	// Spec of the image to remove.
-	Image                *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Force                bool       `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Remove the image even if it is pinned.
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RemoveImageRequest) Reset()         { *m = RemoveImageRequest{} }
 func (m *RemoveImageRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveImageRequest) ProtoMessage()    {}
 func (*RemoveImageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{51}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{67}
 }
 func (m *RemoveImageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveImageRequest.Unmarshal(m, b)
@@ -2980,7 +4221,7 @@ func (m *RemoveImageResponse) Reset()         { *m = RemoveImageResponse{} }
 func (m *RemoveImageResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveImageResponse) ProtoMessage()    {}
 func (*RemoveImageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{52}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{68}
 }
 func (m *RemoveImageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveImageResponse.Unmarshal(m, b)
@@ -3014,93 +4255,598 @@ func (m *RemoveImageResponse) GetCc() uint32 {
 	return 0
 }
 
-type ImageFsInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PinImageRequest struct {
+	// Spec of the image to pin.
+	Image                *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *ImageFsInfoRequest) Reset()         { *m = ImageFsInfoRequest{} }
-func (m *ImageFsInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ImageFsInfoRequest) ProtoMessage()    {}
-func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{53}
+func (m *PinImageRequest) Reset()         { *m = PinImageRequest{} }
+func (m *PinImageRequest) String() string { return proto.CompactTextString(m) }
+func (*PinImageRequest) ProtoMessage()    {}
+func (*PinImageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{69}
 }
-func (m *ImageFsInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImageFsInfoRequest.Unmarshal(m, b)
+func (m *PinImageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinImageRequest.Unmarshal(m, b)
 }
-func (m *ImageFsInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImageFsInfoRequest.Marshal(b, m, deterministic)
+func (m *PinImageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinImageRequest.Marshal(b, m, deterministic)
 }
-func (dst *ImageFsInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImageFsInfoRequest.Merge(dst, src)
+func (dst *PinImageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinImageRequest.Merge(dst, src)
 }
-func (m *ImageFsInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_ImageFsInfoRequest.Size(m)
+func (m *PinImageRequest) XXX_Size() int {
+	return xxx_messageInfo_PinImageRequest.Size(m)
 }
-func (m *ImageFsInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImageFsInfoRequest.DiscardUnknown(m)
+func (m *PinImageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinImageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImageFsInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_PinImageRequest proto.InternalMessageInfo
 
-// UInt64Value is the wrapper of uint64.
-type UInt64Value struct {
-	// The value.
-	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+func (m *PinImageRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type PinImageResponse struct {
+	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UInt64Value) Reset()         { *m = UInt64Value{} }
-func (m *UInt64Value) String() string { return proto.CompactTextString(m) }
-func (*UInt64Value) ProtoMessage()    {}
-func (*UInt64Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{54}
+func (m *PinImageResponse) Reset()         { *m = PinImageResponse{} }
+func (m *PinImageResponse) String() string { return proto.CompactTextString(m) }
+func (*PinImageResponse) ProtoMessage()    {}
+func (*PinImageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{70}
 }
-func (m *UInt64Value) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UInt64Value.Unmarshal(m, b)
+func (m *PinImageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinImageResponse.Unmarshal(m, b)
 }
-func (m *UInt64Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UInt64Value.Marshal(b, m, deterministic)
+func (m *PinImageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinImageResponse.Marshal(b, m, deterministic)
 }
-func (dst *UInt64Value) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UInt64Value.Merge(dst, src)
+func (dst *PinImageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinImageResponse.Merge(dst, src)
 }
-func (m *UInt64Value) XXX_Size() int {
-	return xxx_messageInfo_UInt64Value.Size(m)
+func (m *PinImageResponse) XXX_Size() int {
+	return xxx_messageInfo_PinImageResponse.Size(m)
 }
-func (m *UInt64Value) XXX_DiscardUnknown() {
-	xxx_messageInfo_UInt64Value.DiscardUnknown(m)
+func (m *PinImageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinImageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UInt64Value proto.InternalMessageInfo
+var xxx_messageInfo_PinImageResponse proto.InternalMessageInfo
 
-func (m *UInt64Value) GetValue() uint64 {
+func (m *PinImageResponse) GetErrmsg() string {
 	if m != nil {
-		return m.Value
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *PinImageResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
 	}
 	return 0
 }
 
-// StorageIdentifier uniquely identify the storage..
-type StorageIdentifier struct {
-	// UUID of the device.
-	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UnpinImageRequest struct {
+	// Spec of the image to unpin.
+	Image                *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *StorageIdentifier) Reset()         { *m = StorageIdentifier{} }
-func (m *StorageIdentifier) String() string { return proto.CompactTextString(m) }
-func (*StorageIdentifier) ProtoMessage()    {}
-func (*StorageIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{55}
+func (m *UnpinImageRequest) Reset()         { *m = UnpinImageRequest{} }
+func (m *UnpinImageRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinImageRequest) ProtoMessage()    {}
+func (*UnpinImageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{71}
 }
-func (m *StorageIdentifier) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StorageIdentifier.Unmarshal(m, b)
+func (m *UnpinImageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinImageRequest.Unmarshal(m, b)
+}
+func (m *UnpinImageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinImageRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnpinImageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinImageRequest.Merge(dst, src)
+}
+func (m *UnpinImageRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpinImageRequest.Size(m)
+}
+func (m *UnpinImageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinImageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinImageRequest proto.InternalMessageInfo
+
+func (m *UnpinImageRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+type UnpinImageResponse struct {
+	Errmsg               string   `protobuf:"bytes,1,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,2,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnpinImageResponse) Reset()         { *m = UnpinImageResponse{} }
+func (m *UnpinImageResponse) String() string { return proto.CompactTextString(m) }
+func (*UnpinImageResponse) ProtoMessage()    {}
+func (*UnpinImageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{72}
+}
+func (m *UnpinImageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinImageResponse.Unmarshal(m, b)
+}
+func (m *UnpinImageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinImageResponse.Marshal(b, m, deterministic)
+}
+func (dst *UnpinImageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinImageResponse.Merge(dst, src)
+}
+func (m *UnpinImageResponse) XXX_Size() int {
+	return xxx_messageInfo_UnpinImageResponse.Size(m)
+}
+func (m *UnpinImageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinImageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinImageResponse proto.InternalMessageInfo
+
+func (m *UnpinImageResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *UnpinImageResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+type ImageFsInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageFsInfoRequest) Reset()         { *m = ImageFsInfoRequest{} }
+func (m *ImageFsInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ImageFsInfoRequest) ProtoMessage()    {}
+func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{73}
+}
+func (m *ImageFsInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageFsInfoRequest.Unmarshal(m, b)
+}
+func (m *ImageFsInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageFsInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImageFsInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageFsInfoRequest.Merge(dst, src)
+}
+func (m *ImageFsInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ImageFsInfoRequest.Size(m)
+}
+func (m *ImageFsInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageFsInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageFsInfoRequest proto.InternalMessageInfo
+
+type DiskUsageRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskUsageRequest) Reset()         { *m = DiskUsageRequest{} }
+func (m *DiskUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*DiskUsageRequest) ProtoMessage()    {}
+func (*DiskUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{74}
+}
+func (m *DiskUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskUsageRequest.Unmarshal(m, b)
+}
+func (m *DiskUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskUsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiskUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskUsageRequest.Merge(dst, src)
+}
+func (m *DiskUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_DiskUsageRequest.Size(m)
+}
+func (m *DiskUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskUsageRequest proto.InternalMessageInfo
+
+type ImageDiskUsage struct {
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoTags []string `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	// Total size of the image.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Size of layers shared with other images.
+	SharedSize int64 `protobuf:"varint,4,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
+	// Size freed if only this image is removed.
+	UniqueSize int64 `protobuf:"varint,5,opt,name=unique_size,json=uniqueSize,proto3" json:"unique_size,omitempty"`
+	// Number of containers using the image.
+	Containers           uint32   `protobuf:"varint,6,opt,name=containers,proto3" json:"containers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageDiskUsage) Reset()         { *m = ImageDiskUsage{} }
+func (m *ImageDiskUsage) String() string { return proto.CompactTextString(m) }
+func (*ImageDiskUsage) ProtoMessage()    {}
+func (*ImageDiskUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{75}
+}
+func (m *ImageDiskUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageDiskUsage.Unmarshal(m, b)
+}
+func (m *ImageDiskUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageDiskUsage.Marshal(b, m, deterministic)
+}
+func (dst *ImageDiskUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageDiskUsage.Merge(dst, src)
+}
+func (m *ImageDiskUsage) XXX_Size() int {
+	return xxx_messageInfo_ImageDiskUsage.Size(m)
+}
+func (m *ImageDiskUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageDiskUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageDiskUsage proto.InternalMessageInfo
+
+func (m *ImageDiskUsage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ImageDiskUsage) GetRepoTags() []string {
+	if m != nil {
+		return m.RepoTags
+	}
+	return nil
+}
+
+func (m *ImageDiskUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ImageDiskUsage) GetSharedSize() int64 {
+	if m != nil {
+		return m.SharedSize
+	}
+	return 0
+}
+
+func (m *ImageDiskUsage) GetUniqueSize() int64 {
+	if m != nil {
+		return m.UniqueSize
+	}
+	return 0
+}
+
+func (m *ImageDiskUsage) GetContainers() uint32 {
+	if m != nil {
+		return m.Containers
+	}
+	return 0
+}
+
+type ContainerDiskUsage struct {
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Names   []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	ImageId string   `protobuf:"bytes,3,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	LayerId string   `protobuf:"bytes,4,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	// Size of the RW layer and metadata of the container.
+	Size                 int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerDiskUsage) Reset()         { *m = ContainerDiskUsage{} }
+func (m *ContainerDiskUsage) String() string { return proto.CompactTextString(m) }
+func (*ContainerDiskUsage) ProtoMessage()    {}
+func (*ContainerDiskUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{76}
+}
+func (m *ContainerDiskUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerDiskUsage.Unmarshal(m, b)
+}
+func (m *ContainerDiskUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerDiskUsage.Marshal(b, m, deterministic)
+}
+func (dst *ContainerDiskUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerDiskUsage.Merge(dst, src)
+}
+func (m *ContainerDiskUsage) XXX_Size() int {
+	return xxx_messageInfo_ContainerDiskUsage.Size(m)
+}
+func (m *ContainerDiskUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerDiskUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerDiskUsage proto.InternalMessageInfo
+
+func (m *ContainerDiskUsage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ContainerDiskUsage) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+func (m *ContainerDiskUsage) GetImageId() string {
+	if m != nil {
+		return m.ImageId
+	}
+	return ""
+}
+
+func (m *ContainerDiskUsage) GetLayerId() string {
+	if m != nil {
+		return m.LayerId
+	}
+	return ""
+}
+
+func (m *ContainerDiskUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type LayerDiskUsage struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LayerDiskUsage) Reset()         { *m = LayerDiskUsage{} }
+func (m *LayerDiskUsage) String() string { return proto.CompactTextString(m) }
+func (*LayerDiskUsage) ProtoMessage()    {}
+func (*LayerDiskUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{77}
+}
+func (m *LayerDiskUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayerDiskUsage.Unmarshal(m, b)
+}
+func (m *LayerDiskUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayerDiskUsage.Marshal(b, m, deterministic)
+}
+func (dst *LayerDiskUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayerDiskUsage.Merge(dst, src)
+}
+func (m *LayerDiskUsage) XXX_Size() int {
+	return xxx_messageInfo_LayerDiskUsage.Size(m)
+}
+func (m *LayerDiskUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayerDiskUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LayerDiskUsage proto.InternalMessageInfo
+
+func (m *LayerDiskUsage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LayerDiskUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type DiskUsageResponse struct {
+	Images     []*ImageDiskUsage     `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Containers []*ContainerDiskUsage `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	// Layers used by no image nor container.
+	DanglingLayers []*LayerDiskUsage `protobuf:"bytes,3,rep,name=dangling_layers,json=danglingLayers,proto3" json:"dangling_layers,omitempty"`
+	// Size of all images, layers shared by images are counted once.
+	ImagesSize         int64 `protobuf:"varint,4,opt,name=images_size,json=imagesSize,proto3" json:"images_size,omitempty"`
+	ContainersSize     int64 `protobuf:"varint,5,opt,name=containers_size,json=containersSize,proto3" json:"containers_size,omitempty"`
+	DanglingLayersSize int64 `protobuf:"varint,6,opt,name=dangling_layers_size,json=danglingLayersSize,proto3" json:"dangling_layers_size,omitempty"`
+	// Size freed by removing all images not used by any container and all
+	// dangling layers.
+	ReclaimableSize      int64    `protobuf:"varint,7,opt,name=reclaimable_size,json=reclaimableSize,proto3" json:"reclaimable_size,omitempty"`
+	Errmsg               string   `protobuf:"bytes,8,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32   `protobuf:"varint,9,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiskUsageResponse) Reset()         { *m = DiskUsageResponse{} }
+func (m *DiskUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*DiskUsageResponse) ProtoMessage()    {}
+func (*DiskUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{78}
+}
+func (m *DiskUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiskUsageResponse.Unmarshal(m, b)
+}
+func (m *DiskUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiskUsageResponse.Marshal(b, m, deterministic)
+}
+func (dst *DiskUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskUsageResponse.Merge(dst, src)
+}
+func (m *DiskUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_DiskUsageResponse.Size(m)
+}
+func (m *DiskUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskUsageResponse proto.InternalMessageInfo
+
+func (m *DiskUsageResponse) GetImages() []*ImageDiskUsage {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *DiskUsageResponse) GetContainers() []*ContainerDiskUsage {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+func (m *DiskUsageResponse) GetDanglingLayers() []*LayerDiskUsage {
+	if m != nil {
+		return m.DanglingLayers
+	}
+	return nil
+}
+
+func (m *DiskUsageResponse) GetImagesSize() int64 {
+	if m != nil {
+		return m.ImagesSize
+	}
+	return 0
+}
+
+func (m *DiskUsageResponse) GetContainersSize() int64 {
+	if m != nil {
+		return m.ContainersSize
+	}
+	return 0
+}
+
+func (m *DiskUsageResponse) GetDanglingLayersSize() int64 {
+	if m != nil {
+		return m.DanglingLayersSize
+	}
+	return 0
+}
+
+func (m *DiskUsageResponse) GetReclaimableSize() int64 {
+	if m != nil {
+		return m.ReclaimableSize
+	}
+	return 0
+}
+
+func (m *DiskUsageResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *DiskUsageResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+// UInt64Value is the wrapper of uint64.
+type UInt64Value struct {
+	// The value.
+	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UInt64Value) Reset()         { *m = UInt64Value{} }
+func (m *UInt64Value) String() string { return proto.CompactTextString(m) }
+func (*UInt64Value) ProtoMessage()    {}
+func (*UInt64Value) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{79}
+}
+func (m *UInt64Value) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UInt64Value.Unmarshal(m, b)
+}
+func (m *UInt64Value) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UInt64Value.Marshal(b, m, deterministic)
+}
+func (dst *UInt64Value) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UInt64Value.Merge(dst, src)
+}
+func (m *UInt64Value) XXX_Size() int {
+	return xxx_messageInfo_UInt64Value.Size(m)
+}
+func (m *UInt64Value) XXX_DiscardUnknown() {
+	xxx_messageInfo_UInt64Value.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UInt64Value proto.InternalMessageInfo
+
+func (m *UInt64Value) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// StorageIdentifier uniquely identify the storage..
+type StorageIdentifier struct {
+	// UUID of the device.
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageIdentifier) Reset()         { *m = StorageIdentifier{} }
+func (m *StorageIdentifier) String() string { return proto.CompactTextString(m) }
+func (*StorageIdentifier) ProtoMessage()    {}
+func (*StorageIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{80}
+}
+func (m *StorageIdentifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageIdentifier.Unmarshal(m, b)
 }
 func (m *StorageIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_StorageIdentifier.Marshal(b, m, deterministic)
@@ -3147,103 +4893,395 @@ func (m *FilesystemUsage) Reset()         { *m = FilesystemUsage{} }
 func (m *FilesystemUsage) String() string { return proto.CompactTextString(m) }
 func (*FilesystemUsage) ProtoMessage()    {}
 func (*FilesystemUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{56}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{81}
 }
 func (m *FilesystemUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilesystemUsage.Unmarshal(m, b)
 }
-func (m *FilesystemUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FilesystemUsage.Marshal(b, m, deterministic)
+func (m *FilesystemUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilesystemUsage.Marshal(b, m, deterministic)
+}
+func (dst *FilesystemUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilesystemUsage.Merge(dst, src)
+}
+func (m *FilesystemUsage) XXX_Size() int {
+	return xxx_messageInfo_FilesystemUsage.Size(m)
+}
+func (m *FilesystemUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilesystemUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilesystemUsage proto.InternalMessageInfo
+
+func (m *FilesystemUsage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *FilesystemUsage) GetStorageId() *StorageIdentifier {
+	if m != nil {
+		return m.StorageId
+	}
+	return nil
+}
+
+func (m *FilesystemUsage) GetUsedBytes() *UInt64Value {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return nil
+}
+
+func (m *FilesystemUsage) GetInodesUsed() *UInt64Value {
+	if m != nil {
+		return m.InodesUsed
+	}
+	return nil
+}
+
+type ImageFsInfoResponse struct {
+	// Information of image filesystem(s).
+	ImageFilesystems     []*FilesystemUsage `protobuf:"bytes,1,rep,name=image_filesystems,json=imageFilesystems,proto3" json:"image_filesystems,omitempty"`
+	Errmsg               string             `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32             `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ImageFsInfoResponse) Reset()         { *m = ImageFsInfoResponse{} }
+func (m *ImageFsInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ImageFsInfoResponse) ProtoMessage()    {}
+func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{82}
+}
+func (m *ImageFsInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageFsInfoResponse.Unmarshal(m, b)
+}
+func (m *ImageFsInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageFsInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImageFsInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageFsInfoResponse.Merge(dst, src)
+}
+func (m *ImageFsInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_ImageFsInfoResponse.Size(m)
+}
+func (m *ImageFsInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageFsInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImageFsInfoResponse proto.InternalMessageInfo
+
+func (m *ImageFsInfoResponse) GetImageFilesystems() []*FilesystemUsage {
+	if m != nil {
+		return m.ImageFilesystems
+	}
+	return nil
+}
+
+func (m *ImageFsInfoResponse) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+func (m *ImageFsInfoResponse) GetCc() uint32 {
+	if m != nil {
+		return m.Cc
+	}
+	return 0
+}
+
+type VerifyImagesRequest struct {
+	// Image to verify, all images are verified if not set.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Mark images failed verification unusable. Images passed verification
+	// are released from quarantine.
+	Quarantine bool `protobuf:"varint,2,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	// Fetch failed layers again from the registries the image was pulled
+	// from and extract them under the same layer IDs.
+	Repair bool `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
+	// Fetch failed layers from the image archive instead of registries,
+	// implies repair.
+	Archive              string   `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyImagesRequest) Reset()         { *m = VerifyImagesRequest{} }
+func (m *VerifyImagesRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyImagesRequest) ProtoMessage()    {}
+func (*VerifyImagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{83}
+}
+func (m *VerifyImagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyImagesRequest.Unmarshal(m, b)
+}
+func (m *VerifyImagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyImagesRequest.Marshal(b, m, deterministic)
+}
+func (dst *VerifyImagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyImagesRequest.Merge(dst, src)
+}
+func (m *VerifyImagesRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyImagesRequest.Size(m)
+}
+func (m *VerifyImagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyImagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyImagesRequest proto.InternalMessageInfo
+
+func (m *VerifyImagesRequest) GetImage() *ImageSpec {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
+func (m *VerifyImagesRequest) GetQuarantine() bool {
+	if m != nil {
+		return m.Quarantine
+	}
+	return false
+}
+
+func (m *VerifyImagesRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+func (m *VerifyImagesRequest) GetArchive() string {
+	if m != nil {
+		return m.Archive
+	}
+	return ""
+}
+
+type LayerVerifyResult struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// DiffID recorded in image config.
+	DiffId string `protobuf:"bytes,2,opt,name=diff_id,json=diffId,proto3" json:"diff_id,omitempty"`
+	// DiffID computed from the layer content, empty if the layer can not be read.
+	ComputedDiffId string `protobuf:"bytes,3,opt,name=computed_diff_id,json=computedDiffId,proto3" json:"computed_diff_id,omitempty"`
+	Ok             bool   `protobuf:"varint,4,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error          string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The layer was fetched and extracted again.
+	Repaired             bool     `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LayerVerifyResult) Reset()         { *m = LayerVerifyResult{} }
+func (m *LayerVerifyResult) String() string { return proto.CompactTextString(m) }
+func (*LayerVerifyResult) ProtoMessage()    {}
+func (*LayerVerifyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{84}
+}
+func (m *LayerVerifyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LayerVerifyResult.Unmarshal(m, b)
+}
+func (m *LayerVerifyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LayerVerifyResult.Marshal(b, m, deterministic)
+}
+func (dst *LayerVerifyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LayerVerifyResult.Merge(dst, src)
+}
+func (m *LayerVerifyResult) XXX_Size() int {
+	return xxx_messageInfo_LayerVerifyResult.Size(m)
+}
+func (m *LayerVerifyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_LayerVerifyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LayerVerifyResult proto.InternalMessageInfo
+
+func (m *LayerVerifyResult) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LayerVerifyResult) GetDiffId() string {
+	if m != nil {
+		return m.DiffId
+	}
+	return ""
+}
+
+func (m *LayerVerifyResult) GetComputedDiffId() string {
+	if m != nil {
+		return m.ComputedDiffId
+	}
+	return ""
+}
+
+func (m *LayerVerifyResult) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *LayerVerifyResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *LayerVerifyResult) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+type ImageVerifyResult struct {
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoTags    []string `protobuf:"bytes,2,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	Ok          bool     `protobuf:"varint,3,opt,name=ok,proto3" json:"ok,omitempty"`
+	Quarantined bool     `protobuf:"varint,4,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	Error       string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Layers from the base layer to the top layer.
+	Layers []*LayerVerifyResult `protobuf:"bytes,6,rep,name=layers,proto3" json:"layers,omitempty"`
+	// Why the image could not be repaired.
+	RepairError          string   `protobuf:"bytes,7,opt,name=repair_error,json=repairError,proto3" json:"repair_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImageVerifyResult) Reset()         { *m = ImageVerifyResult{} }
+func (m *ImageVerifyResult) String() string { return proto.CompactTextString(m) }
+func (*ImageVerifyResult) ProtoMessage()    {}
+func (*ImageVerifyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{85}
+}
+func (m *ImageVerifyResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImageVerifyResult.Unmarshal(m, b)
 }
-func (dst *FilesystemUsage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FilesystemUsage.Merge(dst, src)
+func (m *ImageVerifyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImageVerifyResult.Marshal(b, m, deterministic)
 }
-func (m *FilesystemUsage) XXX_Size() int {
-	return xxx_messageInfo_FilesystemUsage.Size(m)
+func (dst *ImageVerifyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImageVerifyResult.Merge(dst, src)
 }
-func (m *FilesystemUsage) XXX_DiscardUnknown() {
-	xxx_messageInfo_FilesystemUsage.DiscardUnknown(m)
+func (m *ImageVerifyResult) XXX_Size() int {
+	return xxx_messageInfo_ImageVerifyResult.Size(m)
+}
+func (m *ImageVerifyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImageVerifyResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FilesystemUsage proto.InternalMessageInfo
+var xxx_messageInfo_ImageVerifyResult proto.InternalMessageInfo
 
-func (m *FilesystemUsage) GetTimestamp() int64 {
+func (m *ImageVerifyResult) GetId() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *FilesystemUsage) GetStorageId() *StorageIdentifier {
+func (m *ImageVerifyResult) GetRepoTags() []string {
 	if m != nil {
-		return m.StorageId
+		return m.RepoTags
 	}
 	return nil
 }
 
-func (m *FilesystemUsage) GetUsedBytes() *UInt64Value {
+func (m *ImageVerifyResult) GetOk() bool {
 	if m != nil {
-		return m.UsedBytes
+		return m.Ok
 	}
-	return nil
+	return false
 }
 
-func (m *FilesystemUsage) GetInodesUsed() *UInt64Value {
+func (m *ImageVerifyResult) GetQuarantined() bool {
 	if m != nil {
-		return m.InodesUsed
+		return m.Quarantined
+	}
+	return false
+}
+
+func (m *ImageVerifyResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ImageVerifyResult) GetLayers() []*LayerVerifyResult {
+	if m != nil {
+		return m.Layers
 	}
 	return nil
 }
 
-type ImageFsInfoResponse struct {
-	// Information of image filesystem(s).
-	ImageFilesystems     []*FilesystemUsage `protobuf:"bytes,1,rep,name=image_filesystems,json=imageFilesystems,proto3" json:"image_filesystems,omitempty"`
-	Errmsg               string             `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Cc                   uint32             `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *ImageVerifyResult) GetRepairError() string {
+	if m != nil {
+		return m.RepairError
+	}
+	return ""
 }
 
-func (m *ImageFsInfoResponse) Reset()         { *m = ImageFsInfoResponse{} }
-func (m *ImageFsInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ImageFsInfoResponse) ProtoMessage()    {}
-func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{57}
+type VerifyImagesResponse struct {
+	Images               []*ImageVerifyResult `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Errmsg               string               `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Cc                   uint32               `protobuf:"varint,3,opt,name=cc,proto3" json:"cc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *ImageFsInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImageFsInfoResponse.Unmarshal(m, b)
+
+func (m *VerifyImagesResponse) Reset()         { *m = VerifyImagesResponse{} }
+func (m *VerifyImagesResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyImagesResponse) ProtoMessage()    {}
+func (*VerifyImagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{86}
 }
-func (m *ImageFsInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImageFsInfoResponse.Marshal(b, m, deterministic)
+func (m *VerifyImagesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyImagesResponse.Unmarshal(m, b)
 }
-func (dst *ImageFsInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImageFsInfoResponse.Merge(dst, src)
+func (m *VerifyImagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyImagesResponse.Marshal(b, m, deterministic)
 }
-func (m *ImageFsInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_ImageFsInfoResponse.Size(m)
+func (dst *VerifyImagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyImagesResponse.Merge(dst, src)
 }
-func (m *ImageFsInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImageFsInfoResponse.DiscardUnknown(m)
+func (m *VerifyImagesResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyImagesResponse.Size(m)
+}
+func (m *VerifyImagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyImagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImageFsInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_VerifyImagesResponse proto.InternalMessageInfo
 
-func (m *ImageFsInfoResponse) GetImageFilesystems() []*FilesystemUsage {
+func (m *VerifyImagesResponse) GetImages() []*ImageVerifyResult {
 	if m != nil {
-		return m.ImageFilesystems
+		return m.Images
 	}
 	return nil
 }
 
-func (m *ImageFsInfoResponse) GetErrmsg() string {
+func (m *VerifyImagesResponse) GetErrmsg() string {
 	if m != nil {
 		return m.Errmsg
 	}
 	return ""
 }
 
-func (m *ImageFsInfoResponse) GetCc() uint32 {
+func (m *VerifyImagesResponse) GetCc() uint32 {
 	if m != nil {
 		return m.Cc
 	}
@@ -3262,7 +5300,7 @@ func (m *TagImageRequest) Reset()         { *m = TagImageRequest{} }
 func (m *TagImageRequest) String() string { return proto.CompactTextString(m) }
 func (*TagImageRequest) ProtoMessage()    {}
 func (*TagImageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{58}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{87}
 }
 func (m *TagImageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagImageRequest.Unmarshal(m, b)
@@ -3308,7 +5346,7 @@ func (m *TagImageResponse) Reset()         { *m = TagImageResponse{} }
 func (m *TagImageResponse) String() string { return proto.CompactTextString(m) }
 func (*TagImageResponse) ProtoMessage()    {}
 func (*TagImageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_isula_image_231213ed9612ec4a, []int{59}
+	return fileDescriptor_isula_image_2aa07529653b62c7, []int{88}
 }
 func (m *TagImageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TagImageResponse.Unmarshal(m, b)
@@ -3349,6 +5387,13 @@ func init() {
 	proto.RegisterType((*LoginResponse)(nil), "isula.LoginResponse")
 	proto.RegisterType((*LogoutRequest)(nil), "isula.LogoutRequest")
 	proto.RegisterType((*LogoutResponse)(nil), "isula.LogoutResponse")
+	proto.RegisterType((*LoginInfo)(nil), "isula.LoginInfo")
+	proto.RegisterType((*ListLoginsRequest)(nil), "isula.ListLoginsRequest")
+	proto.RegisterType((*ListLoginsResponse)(nil), "isula.ListLoginsResponse")
+	proto.RegisterType((*LogoutAllRequest)(nil), "isula.LogoutAllRequest")
+	proto.RegisterType((*LogoutAllResponse)(nil), "isula.LogoutAllResponse")
+	proto.RegisterType((*RotateAuthKeyRequest)(nil), "isula.RotateAuthKeyRequest")
+	proto.RegisterType((*RotateAuthKeyResponse)(nil), "isula.RotateAuthKeyResponse")
 	proto.RegisterType((*ContainerExportRequest)(nil), "isula.ContainerExportRequest")
 	proto.RegisterType((*ContainerExportResponse)(nil), "isula.ContainerExportResponse")
 	proto.RegisterType((*LoadImageRequest)(nil), "isula.LoadImageRequest")
@@ -3356,21 +5401,30 @@ func init() {
 	proto.RegisterType((*ImportRequest)(nil), "isula.ImportRequest")
 	proto.RegisterType((*ImportResponose)(nil), "isula.ImportResponose")
 	proto.RegisterType((*GraphdriverStatusRequest)(nil), "isula.GraphdriverStatusRequest")
+	proto.RegisterType((*OverlayStatus)(nil), "isula.OverlayStatus")
+	proto.RegisterType((*DevicemapperStatus)(nil), "isula.DevicemapperStatus")
 	proto.RegisterType((*GraphdriverStatusResponse)(nil), "isula.GraphdriverStatusResponse")
 	proto.RegisterType((*GraphdriverMetadataRequest)(nil), "isula.GraphdriverMetadataRequest")
 	proto.RegisterType((*GraphdriverMetadataResponse)(nil), "isula.GraphdriverMetadataResponse")
 	proto.RegisterMapType((map[string]string)(nil), "isula.GraphdriverMetadataResponse.MetadataEntry")
 	proto.RegisterType((*ContainerFsUsageRequest)(nil), "isula.ContainerFsUsageRequest")
 	proto.RegisterType((*ContainerFsUsageResponse)(nil), "isula.ContainerFsUsageResponse")
+	proto.RegisterType((*ContainerSetQuotaRequest)(nil), "isula.ContainerSetQuotaRequest")
+	proto.RegisterType((*ContainerSetQuotaResponse)(nil), "isula.ContainerSetQuotaResponse")
 	proto.RegisterType((*ContainerUmountRequest)(nil), "isula.ContainerUmountRequest")
 	proto.RegisterType((*ContainerUmountResponse)(nil), "isula.ContainerUmountResponse")
 	proto.RegisterType((*ContainerMountRequest)(nil), "isula.ContainerMountRequest")
 	proto.RegisterType((*ContainerMountResponse)(nil), "isula.ContainerMountResponse")
 	proto.RegisterType((*ContainerRemoveRequest)(nil), "isula.ContainerRemoveRequest")
 	proto.RegisterType((*ContainerRemoveResponse)(nil), "isula.ContainerRemoveResponse")
+	proto.RegisterType((*RemovePodRequest)(nil), "isula.RemovePodRequest")
+	proto.RegisterType((*RemovePodResponse)(nil), "isula.RemovePodResponse")
+	proto.RegisterType((*PodInfo)(nil), "isula.PodInfo")
+	proto.RegisterType((*IDMap)(nil), "isula.IDMap")
 	proto.RegisterType((*ContainerPrepareRequest)(nil), "isula.ContainerPrepareRequest")
 	proto.RegisterType((*ContainerPrepareResponse)(nil), "isula.ContainerPrepareResponse")
 	proto.RegisterType((*ListContainersRequest)(nil), "isula.ListContainersRequest")
+	proto.RegisterType((*ContainerInfo)(nil), "isula.ContainerInfo")
 	proto.RegisterType((*ListContainersResponse)(nil), "isula.ListContainersResponse")
 	proto.RegisterMapType((map[string]bool)(nil), "isula.ListContainersResponse.ContainersEntry")
 	proto.RegisterType((*DNSConfig)(nil), "isula.DNSConfig")
@@ -3402,11 +5456,24 @@ func init() {
 	proto.RegisterType((*PullImageResponse)(nil), "isula.PullImageResponse")
 	proto.RegisterType((*RemoveImageRequest)(nil), "isula.RemoveImageRequest")
 	proto.RegisterType((*RemoveImageResponse)(nil), "isula.RemoveImageResponse")
+	proto.RegisterType((*PinImageRequest)(nil), "isula.PinImageRequest")
+	proto.RegisterType((*PinImageResponse)(nil), "isula.PinImageResponse")
+	proto.RegisterType((*UnpinImageRequest)(nil), "isula.UnpinImageRequest")
+	proto.RegisterType((*UnpinImageResponse)(nil), "isula.UnpinImageResponse")
 	proto.RegisterType((*ImageFsInfoRequest)(nil), "isula.ImageFsInfoRequest")
+	proto.RegisterType((*DiskUsageRequest)(nil), "isula.DiskUsageRequest")
+	proto.RegisterType((*ImageDiskUsage)(nil), "isula.ImageDiskUsage")
+	proto.RegisterType((*ContainerDiskUsage)(nil), "isula.ContainerDiskUsage")
+	proto.RegisterType((*LayerDiskUsage)(nil), "isula.LayerDiskUsage")
+	proto.RegisterType((*DiskUsageResponse)(nil), "isula.DiskUsageResponse")
 	proto.RegisterType((*UInt64Value)(nil), "isula.UInt64Value")
 	proto.RegisterType((*StorageIdentifier)(nil), "isula.StorageIdentifier")
 	proto.RegisterType((*FilesystemUsage)(nil), "isula.FilesystemUsage")
 	proto.RegisterType((*ImageFsInfoResponse)(nil), "isula.ImageFsInfoResponse")
+	proto.RegisterType((*VerifyImagesRequest)(nil), "isula.VerifyImagesRequest")
+	proto.RegisterType((*LayerVerifyResult)(nil), "isula.LayerVerifyResult")
+	proto.RegisterType((*ImageVerifyResult)(nil), "isula.ImageVerifyResult")
+	proto.RegisterType((*VerifyImagesResponse)(nil), "isula.VerifyImagesResponse")
 	proto.RegisterType((*TagImageRequest)(nil), "isula.TagImageRequest")
 	proto.RegisterType((*TagImageResponse)(nil), "isula.TagImageResponse")
 	proto.RegisterEnum("isula.Protocol", Protocol_name, Protocol_value)
@@ -3439,12 +5506,20 @@ type ImageServiceClient interface {
 	// This call is idempotent, and must not return an error if the image has
 	// already been removed.
 	RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error)
+	// PinImage protects the image from removal without force and from eviction.
+	PinImage(ctx context.Context, in *PinImageRequest, opts ...grpc.CallOption) (*PinImageResponse, error)
+	// UnpinImage unpins the image.
+	UnpinImage(ctx context.Context, in *UnpinImageRequest, opts ...grpc.CallOption) (*UnpinImageResponse, error)
 	// ImageFSInfo returns information of the filesystem that is used to store images.
 	ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error)
+	// DiskUsage returns disk usage of images, containers and dangling layers.
+	DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (*DiskUsageResponse, error)
 	// Load image from file
 	LoadImage(ctx context.Context, in *LoadImageRequest, opts ...grpc.CallOption) (*LoadImageResponose, error)
 	// Import rootfs to be image
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponose, error)
+	// Verify layers of images against diffIDs in image config
+	VerifyImages(ctx context.Context, in *VerifyImagesRequest, opts ...grpc.CallOption) (*VerifyImagesResponse, error)
 	// isulad image services
 	// get all Container rootfs
 	ListContainers(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (*ListContainersResponse, error)
@@ -3452,6 +5527,8 @@ type ImageServiceClient interface {
 	ContainerPrepare(ctx context.Context, in *ContainerPrepareRequest, opts ...grpc.CallOption) (*ContainerPrepareResponse, error)
 	// remove rootfs of container
 	ContainerRemove(ctx context.Context, in *ContainerRemoveRequest, opts ...grpc.CallOption) (*ContainerRemoveResponse, error)
+	// remove rootfs of all containers of a pod
+	RemovePod(ctx context.Context, in *RemovePodRequest, opts ...grpc.CallOption) (*RemovePodResponse, error)
 	// mount rwlayer for container
 	ContainerMount(ctx context.Context, in *ContainerMountRequest, opts ...grpc.CallOption) (*ContainerMountResponse, error)
 	// umount rwlayer of container
@@ -3460,6 +5537,8 @@ type ImageServiceClient interface {
 	ContainerExport(ctx context.Context, in *ContainerExportRequest, opts ...grpc.CallOption) (*ContainerExportResponse, error)
 	// get filesystem usage of container
 	ContainerFsUsage(ctx context.Context, in *ContainerFsUsageRequest, opts ...grpc.CallOption) (*ContainerFsUsageResponse, error)
+	// change size limit of container filesystem
+	ContainerSetQuota(ctx context.Context, in *ContainerSetQuotaRequest, opts ...grpc.CallOption) (*ContainerSetQuotaResponse, error)
 	// get status of graphdriver
 	GraphdriverStatus(ctx context.Context, in *GraphdriverStatusRequest, opts ...grpc.CallOption) (*GraphdriverStatusResponse, error)
 	// get metadata of graphdriver
@@ -3468,6 +5547,12 @@ type ImageServiceClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	// logout registry
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// list registries logged in, secrets are never returned
+	ListLogins(ctx context.Context, in *ListLoginsRequest, opts ...grpc.CallOption) (*ListLoginsResponse, error)
+	// logout all registries
+	LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllResponse, error)
+	// rotate the AES key used to encrypt auths
+	RotateAuthKey(ctx context.Context, in *RotateAuthKeyRequest, opts ...grpc.CallOption) (*RotateAuthKeyResponse, error)
 	// health check service
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	// Add a tag to the image
@@ -3527,6 +5612,24 @@ func (c *imageServiceClient) RemoveImage(ctx context.Context, in *RemoveImageReq
 	return out, nil
 }
 
+func (c *imageServiceClient) PinImage(ctx context.Context, in *PinImageRequest, opts ...grpc.CallOption) (*PinImageResponse, error) {
+	out := new(PinImageResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/PinImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) UnpinImage(ctx context.Context, in *UnpinImageRequest, opts ...grpc.CallOption) (*UnpinImageResponse, error) {
+	out := new(UnpinImageResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/UnpinImage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error) {
 	out := new(ImageFsInfoResponse)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/ImageFsInfo", in, out, opts...)
@@ -3536,6 +5639,15 @@ func (c *imageServiceClient) ImageFsInfo(ctx context.Context, in *ImageFsInfoReq
 	return out, nil
 }
 
+func (c *imageServiceClient) DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (*DiskUsageResponse, error) {
+	out := new(DiskUsageResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/DiskUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) LoadImage(ctx context.Context, in *LoadImageRequest, opts ...grpc.CallOption) (*LoadImageResponose, error) {
 	out := new(LoadImageResponose)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/LoadImage", in, out, opts...)
@@ -3554,6 +5666,15 @@ func (c *imageServiceClient) Import(ctx context.Context, in *ImportRequest, opts
 	return out, nil
 }
 
+func (c *imageServiceClient) VerifyImages(ctx context.Context, in *VerifyImagesRequest, opts ...grpc.CallOption) (*VerifyImagesResponse, error) {
+	out := new(VerifyImagesResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/VerifyImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) ListContainers(ctx context.Context, in *ListContainersRequest, opts ...grpc.CallOption) (*ListContainersResponse, error) {
 	out := new(ListContainersResponse)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/ListContainers", in, out, opts...)
@@ -3581,6 +5702,15 @@ func (c *imageServiceClient) ContainerRemove(ctx context.Context, in *ContainerR
 	return out, nil
 }
 
+func (c *imageServiceClient) RemovePod(ctx context.Context, in *RemovePodRequest, opts ...grpc.CallOption) (*RemovePodResponse, error) {
+	out := new(RemovePodResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/RemovePod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) ContainerMount(ctx context.Context, in *ContainerMountRequest, opts ...grpc.CallOption) (*ContainerMountResponse, error) {
 	out := new(ContainerMountResponse)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/ContainerMount", in, out, opts...)
@@ -3617,6 +5747,15 @@ func (c *imageServiceClient) ContainerFsUsage(ctx context.Context, in *Container
 	return out, nil
 }
 
+func (c *imageServiceClient) ContainerSetQuota(ctx context.Context, in *ContainerSetQuotaRequest, opts ...grpc.CallOption) (*ContainerSetQuotaResponse, error) {
+	out := new(ContainerSetQuotaResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/ContainerSetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) GraphdriverStatus(ctx context.Context, in *GraphdriverStatusRequest, opts ...grpc.CallOption) (*GraphdriverStatusResponse, error) {
 	out := new(GraphdriverStatusResponse)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/GraphdriverStatus", in, out, opts...)
@@ -3653,6 +5792,33 @@ func (c *imageServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts
 	return out, nil
 }
 
+func (c *imageServiceClient) ListLogins(ctx context.Context, in *ListLoginsRequest, opts ...grpc.CallOption) (*ListLoginsResponse, error) {
+	out := new(ListLoginsResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/ListLogins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) LogoutAll(ctx context.Context, in *LogoutAllRequest, opts ...grpc.CallOption) (*LogoutAllResponse, error) {
+	out := new(LogoutAllResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/LogoutAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) RotateAuthKey(ctx context.Context, in *RotateAuthKeyRequest, opts ...grpc.CallOption) (*RotateAuthKeyResponse, error) {
+	out := new(RotateAuthKeyResponse)
+	err := c.cc.Invoke(ctx, "/isula.ImageService/RotateAuthKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *imageServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, "/isula.ImageService/HealthCheck", in, out, opts...)
@@ -3687,12 +5853,20 @@ type ImageServiceServer interface {
 	// This call is idempotent, and must not return an error if the image has
 	// already been removed.
 	RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error)
+	// PinImage protects the image from removal without force and from eviction.
+	PinImage(context.Context, *PinImageRequest) (*PinImageResponse, error)
+	// UnpinImage unpins the image.
+	UnpinImage(context.Context, *UnpinImageRequest) (*UnpinImageResponse, error)
 	// ImageFSInfo returns information of the filesystem that is used to store images.
 	ImageFsInfo(context.Context, *ImageFsInfoRequest) (*ImageFsInfoResponse, error)
+	// DiskUsage returns disk usage of images, containers and dangling layers.
+	DiskUsage(context.Context, *DiskUsageRequest) (*DiskUsageResponse, error)
 	// Load image from file
 	LoadImage(context.Context, *LoadImageRequest) (*LoadImageResponose, error)
 	// Import rootfs to be image
 	Import(context.Context, *ImportRequest) (*ImportResponose, error)
+	// Verify layers of images against diffIDs in image config
+	VerifyImages(context.Context, *VerifyImagesRequest) (*VerifyImagesResponse, error)
 	// isulad image services
 	// get all Container rootfs
 	ListContainers(context.Context, *ListContainersRequest) (*ListContainersResponse, error)
@@ -3700,6 +5874,8 @@ type ImageServiceServer interface {
 	ContainerPrepare(context.Context, *ContainerPrepareRequest) (*ContainerPrepareResponse, error)
 	// remove rootfs of container
 	ContainerRemove(context.Context, *ContainerRemoveRequest) (*ContainerRemoveResponse, error)
+	// remove rootfs of all containers of a pod
+	RemovePod(context.Context, *RemovePodRequest) (*RemovePodResponse, error)
 	// mount rwlayer for container
 	ContainerMount(context.Context, *ContainerMountRequest) (*ContainerMountResponse, error)
 	// umount rwlayer of container
@@ -3708,6 +5884,8 @@ type ImageServiceServer interface {
 	ContainerExport(context.Context, *ContainerExportRequest) (*ContainerExportResponse, error)
 	// get filesystem usage of container
 	ContainerFsUsage(context.Context, *ContainerFsUsageRequest) (*ContainerFsUsageResponse, error)
+	// change size limit of container filesystem
+	ContainerSetQuota(context.Context, *ContainerSetQuotaRequest) (*ContainerSetQuotaResponse, error)
 	// get status of graphdriver
 	GraphdriverStatus(context.Context, *GraphdriverStatusRequest) (*GraphdriverStatusResponse, error)
 	// get metadata of graphdriver
@@ -3716,6 +5894,12 @@ type ImageServiceServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	// logout registry
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// list registries logged in, secrets are never returned
+	ListLogins(context.Context, *ListLoginsRequest) (*ListLoginsResponse, error)
+	// logout all registries
+	LogoutAll(context.Context, *LogoutAllRequest) (*LogoutAllResponse, error)
+	// rotate the AES key used to encrypt auths
+	RotateAuthKey(context.Context, *RotateAuthKeyRequest) (*RotateAuthKeyResponse, error)
 	// health check service
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	// Add a tag to the image
@@ -3816,6 +6000,42 @@ func _ImageService_RemoveImage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_PinImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).PinImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/PinImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).PinImage(ctx, req.(*PinImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_UnpinImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).UnpinImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/UnpinImage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).UnpinImage(ctx, req.(*UnpinImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_ImageFsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImageFsInfoRequest)
 	if err := dec(in); err != nil {
@@ -3834,6 +6054,24 @@ func _ImageService_ImageFsInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_DiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).DiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/DiskUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).DiskUsage(ctx, req.(*DiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_LoadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoadImageRequest)
 	if err := dec(in); err != nil {
@@ -3870,6 +6108,24 @@ func _ImageService_Import_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_VerifyImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).VerifyImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/VerifyImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).VerifyImages(ctx, req.(*VerifyImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_ListContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListContainersRequest)
 	if err := dec(in); err != nil {
@@ -3924,6 +6180,24 @@ func _ImageService_ContainerRemove_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_RemovePod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).RemovePod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/RemovePod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).RemovePod(ctx, req.(*RemovePodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_ContainerMount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainerMountRequest)
 	if err := dec(in); err != nil {
@@ -3996,6 +6270,24 @@ func _ImageService_ContainerFsUsage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_ContainerSetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerSetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ContainerSetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/ContainerSetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ContainerSetQuota(ctx, req.(*ContainerSetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_GraphdriverStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GraphdriverStatusRequest)
 	if err := dec(in); err != nil {
@@ -4068,6 +6360,60 @@ func _ImageService_Logout_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_ListLogins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListLogins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/ListLogins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListLogins(ctx, req.(*ListLoginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_LogoutAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).LogoutAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/LogoutAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).LogoutAll(ctx, req.(*LogoutAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_RotateAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAuthKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).RotateAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/isula.ImageService/RotateAuthKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).RotateAuthKey(ctx, req.(*RotateAuthKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ImageService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -4128,10 +6474,22 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveImage",
 			Handler:    _ImageService_RemoveImage_Handler,
 		},
+		{
+			MethodName: "PinImage",
+			Handler:    _ImageService_PinImage_Handler,
+		},
+		{
+			MethodName: "UnpinImage",
+			Handler:    _ImageService_UnpinImage_Handler,
+		},
 		{
 			MethodName: "ImageFsInfo",
 			Handler:    _ImageService_ImageFsInfo_Handler,
 		},
+		{
+			MethodName: "DiskUsage",
+			Handler:    _ImageService_DiskUsage_Handler,
+		},
 		{
 			MethodName: "LoadImage",
 			Handler:    _ImageService_LoadImage_Handler,
@@ -4140,6 +6498,10 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Import",
 			Handler:    _ImageService_Import_Handler,
 		},
+		{
+			MethodName: "VerifyImages",
+			Handler:    _ImageService_VerifyImages_Handler,
+		},
 		{
 			MethodName: "ListContainers",
 			Handler:    _ImageService_ListContainers_Handler,
@@ -4152,6 +6514,10 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerRemove",
 			Handler:    _ImageService_ContainerRemove_Handler,
 		},
+		{
+			MethodName: "RemovePod",
+			Handler:    _ImageService_RemovePod_Handler,
+		},
 		{
 			MethodName: "ContainerMount",
 			Handler:    _ImageService_ContainerMount_Handler,
@@ -4168,6 +6534,10 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerFsUsage",
 			Handler:    _ImageService_ContainerFsUsage_Handler,
 		},
+		{
+			MethodName: "ContainerSetQuota",
+			Handler:    _ImageService_ContainerSetQuota_Handler,
+		},
 		{
 			MethodName: "GraphdriverStatus",
 			Handler:    _ImageService_GraphdriverStatus_Handler,
@@ -4184,6 +6554,18 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _ImageService_Logout_Handler,
 		},
+		{
+			MethodName: "ListLogins",
+			Handler:    _ImageService_ListLogins_Handler,
+		},
+		{
+			MethodName: "LogoutAll",
+			Handler:    _ImageService_LogoutAll_Handler,
+		},
+		{
+			MethodName: "RotateAuthKey",
+			Handler:    _ImageService_RotateAuthKey_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _ImageService_HealthCheck_Handler,
@@ -4198,182 +6580,287 @@ var _ImageService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("isula/isula_image.proto", fileDescriptor_isula_image_231213ed9612ec4a)
-}
-
-var fileDescriptor_isula_image_231213ed9612ec4a = []byte{
-	// 2765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0x24, 0x45, 0x89, 0x7c, 0x14, 0x25, 0x6a, 0xa4, 0x58, 0x14, 0x6d, 0x27, 0xf6, 0x3a, 0xa9,
-	0x5d, 0xb7, 0x55, 0x12, 0x26, 0xa9, 0x9d, 0x04, 0x0e, 0xa2, 0xc8, 0x1f, 0x61, 0xa1, 0x0f, 0x62,
-	0x25, 0x05, 0x01, 0x02, 0x64, 0xb1, 0xde, 0x1d, 0x52, 0x5b, 0x93, 0x3b, 0xdb, 0x99, 0x59, 0xc5,
-	0xea, 0xa9, 0x08, 0xd0, 0x5b, 0xef, 0x3d, 0xf7, 0xd6, 0x43, 0x7f, 0x41, 0x4f, 0xbd, 0x17, 0x2d,
-	0x7a, 0xe8, 0x4f, 0xe8, 0x0f, 0x29, 0xe6, 0x6b, 0x39, 0xcb, 0x0f, 0x8b, 0xcc, 0x85, 0xd8, 0xf7,
-	0xe6, 0xbd, 0x37, 0xef, 0x6b, 0xde, 0xbc, 0x99, 0x21, 0xec, 0x44, 0x2c, 0x1d, 0xf8, 0xef, 0xcb,
-	0x5f, 0x2f, 0x1a, 0xfa, 0x7d, 0xbc, 0x97, 0x50, 0xc2, 0x09, 0x2a, 0x4b, 0x94, 0xb3, 0x0d, 0xe8,
-	0x6b, 0xec, 0x0f, 0xf8, 0xc5, 0xc1, 0x05, 0x0e, 0x5e, 0xb9, 0xf8, 0x77, 0x29, 0x66, 0xdc, 0x79,
-	0x02, 0x5b, 0x39, 0x2c, 0x4b, 0x48, 0xcc, 0x30, 0xba, 0x01, 0x2b, 0x98, 0xd2, 0x21, 0xeb, 0x37,
-	0x0b, 0x77, 0x0a, 0x0f, 0xaa, 0xae, 0x86, 0xd0, 0x3a, 0x14, 0x83, 0xa0, 0x59, 0xbc, 0x53, 0x78,
-	0x50, 0x77, 0x8b, 0x41, 0xe0, 0x7c, 0x0f, 0x6b, 0x87, 0xa4, 0x1f, 0xc5, 0x5a, 0x9c, 0xe0, 0x63,
-	0x98, 0x5e, 0x62, 0x6a, 0xf8, 0x14, 0x84, 0x5a, 0x50, 0x49, 0x19, 0xa6, 0xb1, 0x3f, 0xc4, 0x92,
-	0xbb, 0xea, 0x66, 0xb0, 0x18, 0x4b, 0x7c, 0xc6, 0x7e, 0x20, 0x34, 0x6c, 0x96, 0xd4, 0x98, 0x81,
-	0x9d, 0x47, 0x50, 0xd7, 0xf2, 0x17, 0x54, 0xec, 0xbe, 0x64, 0x24, 0x29, 0xbf, 0x46, 0x33, 0xe7,
-	0x31, 0xac, 0x1b, 0xc2, 0x05, 0xa7, 0xf8, 0x63, 0x01, 0x6e, 0x1c, 0x90, 0x98, 0xfb, 0x51, 0x8c,
-	0xe9, 0xb3, 0xd7, 0x09, 0xa1, 0xd9, 0x64, 0x3b, 0xb0, 0x2a, 0x4c, 0xf3, 0xa2, 0xd0, 0xc8, 0x10,
-	0x60, 0x27, 0x14, 0xb2, 0x49, 0xca, 0x93, 0x94, 0x6b, 0x2f, 0x68, 0x08, 0x35, 0xa0, 0x94, 0x46,
-	0xca, 0xfc, 0xba, 0x2b, 0x3e, 0x05, 0xa6, 0x1f, 0x85, 0xcd, 0x65, 0x85, 0xe9, 0x47, 0x8a, 0xb7,
-	0xd7, 0x63, 0x98, 0x37, 0xcb, 0x12, 0xa9, 0x21, 0x67, 0x1f, 0x76, 0x26, 0xd4, 0x58, 0xd0, 0x94,
-	0xc7, 0xd0, 0x38, 0x24, 0x7e, 0xd8, 0x11, 0x59, 0x63, 0x6c, 0x40, 0xb0, 0xdc, 0x8b, 0x06, 0x58,
-	0x73, 0xca, 0x6f, 0xa1, 0x14, 0xf7, 0xfb, 0x5a, 0x77, 0xf1, 0xe9, 0x9c, 0x01, 0xb2, 0x38, 0xc5,
-	0xb4, 0x44, 0xcd, 0x4b, 0x52, 0x6e, 0xcd, 0xab, 0x20, 0x4b, 0x9f, 0xe2, 0x14, 0x7d, 0x4a, 0x99,
-	0x3e, 0x9f, 0x40, 0xbd, 0x33, 0xb4, 0x1d, 0x3a, 0x9f, 0x32, 0x1d, 0xd8, 0x30, 0x6c, 0x46, 0x93,
-	0x75, 0x28, 0x66, 0x41, 0x28, 0x2a, 0x27, 0xce, 0xa5, 0x41, 0x0b, 0x9a, 0x2f, 0xa8, 0x9f, 0x5c,
-	0x84, 0x34, 0xba, 0xc4, 0xf4, 0x94, 0xfb, 0x3c, 0x65, 0x66, 0xcd, 0x7c, 0x07, 0xbb, 0x53, 0xc6,
-	0x46, 0x2e, 0x67, 0x12, 0x93, 0xe5, 0x99, 0x84, 0x16, 0x30, 0xbd, 0x65, 0x09, 0x3f, 0xc2, 0xdc,
-	0x0f, 0x7d, 0xee, 0x5f, 0x97, 0x58, 0xce, 0xff, 0x0a, 0x70, 0x73, 0x2a, 0x9f, 0x56, 0xeb, 0x10,
-	0x2a, 0x43, 0x8d, 0x6b, 0x16, 0xee, 0x94, 0x1e, 0xd4, 0xda, 0x1f, 0xec, 0xc9, 0xba, 0xb0, 0xf7,
-	0x06, 0xae, 0x3d, 0x83, 0x78, 0x16, 0x73, 0x7a, 0xe5, 0x66, 0x12, 0x44, 0x38, 0xac, 0xa5, 0x2c,
-	0xbf, 0x2d, 0x03, 0x4b, 0x53, 0x0c, 0x5c, 0x36, 0x06, 0xb6, 0x3e, 0x87, 0x7a, 0x4e, 0xac, 0x88,
-	0xe3, 0x2b, 0x7c, 0xa5, 0xed, 0x11, 0x9f, 0x68, 0x1b, 0xca, 0x97, 0xfe, 0x20, 0x35, 0xf2, 0x15,
-	0xf0, 0x59, 0xf1, 0x71, 0xc1, 0x69, 0x5b, 0xb9, 0xfe, 0x9c, 0x9d, 0x33, 0x2b, 0x5f, 0x67, 0xba,
-	0xe6, 0x5b, 0x68, 0x4e, 0xf2, 0x68, 0xb7, 0x6c, 0x43, 0x39, 0x15, 0x08, 0xcd, 0xa2, 0x80, 0xb9,
-	0x63, 0xf5, 0xc2, 0x2a, 0x00, 0xe7, 0x43, 0x92, 0xc6, 0xd7, 0x17, 0x80, 0x6d, 0x28, 0xf7, 0x08,
-	0x0d, 0x94, 0x69, 0x15, 0x57, 0x01, 0xb9, 0x25, 0x6c, 0x04, 0x2d, 0xb8, 0x84, 0x3f, 0x80, 0xb7,
-	0x32, 0x11, 0x47, 0xf3, 0xa8, 0xe2, 0x7c, 0x69, 0x69, 0x7f, 0xf4, 0x93, 0xe6, 0xfc, 0xd0, 0x92,
-	0xe0, 0xe2, 0x21, 0xb9, 0xbc, 0x3e, 0x18, 0xb6, 0xa5, 0x86, 0x65, 0xc1, 0x59, 0x2f, 0x2d, 0x11,
-	0x5d, 0x8a, 0x13, 0x9f, 0x66, 0xd3, 0x6e, 0x43, 0x59, 0xee, 0x7c, 0x26, 0x9c, 0x12, 0xd0, 0x35,
-	0xa0, 0x98, 0xd5, 0x00, 0x93, 0xbd, 0x25, 0x2b, 0x7b, 0xef, 0xc2, 0x1a, 0xe3, 0x84, 0xfa, 0x7d,
-	0xec, 0x91, 0x84, 0xb3, 0xe6, 0xf2, 0x9d, 0xd2, 0x83, 0xaa, 0x5b, 0xd3, 0xb8, 0x93, 0x84, 0x33,
-	0xe7, 0xc7, 0x82, 0x95, 0x48, 0xd9, 0xc4, 0x5a, 0xf9, 0x77, 0xa0, 0x26, 0xe3, 0xe6, 0x25, 0x24,
-	0x8a, 0xb9, 0x9e, 0x1f, 0x24, 0xaa, 0x2b, 0x30, 0xe8, 0x36, 0x80, 0xd4, 0xc6, 0x0b, 0x48, 0xdc,
-	0xd3, 0xca, 0x54, 0x25, 0xe6, 0x80, 0xc4, 0xbd, 0x79, 0x57, 0x8f, 0xb3, 0x03, 0x6f, 0x1d, 0x46,
-	0x8c, 0x67, 0x7a, 0x64, 0x45, 0xe9, 0xdf, 0x05, 0xb8, 0x31, 0x3e, 0xa2, 0x75, 0x3b, 0x02, 0x08,
-	0x32, 0xac, 0x5e, 0xfd, 0xbf, 0xd2, 0xab, 0x7f, 0x3a, 0xcb, 0xde, 0x08, 0xa5, 0x96, 0xbe, 0x25,
-	0x60, 0xde, 0xd5, 0xd1, 0x7a, 0x02, 0x1b, 0x63, 0x62, 0xae, 0x5b, 0xea, 0x15, 0x7b, 0xa9, 0x7f,
-	0x07, 0xd5, 0xa7, 0xc7, 0xa7, 0xc2, 0x39, 0x51, 0x1f, 0x35, 0x61, 0x55, 0xed, 0xd7, 0x4a, 0xff,
-	0xaa, 0x6b, 0x40, 0xd1, 0x3d, 0x30, 0xec, 0xd3, 0xe0, 0x02, 0xb3, 0x66, 0x51, 0x0e, 0x65, 0xb0,
-	0xe0, 0x22, 0x09, 0x8f, 0x48, 0xcc, 0x9a, 0x25, 0xc5, 0xa5, 0x41, 0xe7, 0xcf, 0x05, 0xa8, 0x75,
-	0x09, 0xe5, 0x47, 0x7e, 0x92, 0x44, 0x71, 0x1f, 0xfd, 0x02, 0x2a, 0xb2, 0x59, 0x0a, 0xc8, 0x40,
-	0x6a, 0xb7, 0xde, 0xde, 0xd0, 0x0e, 0xea, 0x6a, 0xb4, 0x9b, 0x11, 0xa0, 0xf7, 0x60, 0x3d, 0x73,
-	0x87, 0x27, 0xb6, 0x1b, 0xa9, 0x7c, 0xd9, 0xad, 0x67, 0x58, 0x21, 0x1a, 0xdd, 0x84, 0xea, 0x05,
-	0x61, 0x5c, 0x51, 0x94, 0x24, 0x45, 0x45, 0x20, 0xe4, 0xe0, 0x0e, 0xac, 0xca, 0xc1, 0x28, 0x91,
-	0xc1, 0xad, 0xba, 0x2b, 0x02, 0xec, 0x24, 0xce, 0x3f, 0x0b, 0x50, 0x96, 0xab, 0x71, 0x6c, 0x1a,
-	0x9f, 0x5f, 0x68, 0xbf, 0x59, 0xd3, 0xf8, 0xfc, 0x62, 0x34, 0x8d, 0xa0, 0xd0, 0xbd, 0x95, 0x9c,
-	0x46, 0x0c, 0xb6, 0xa0, 0x42, 0xb1, 0x1f, 0x92, 0x78, 0x70, 0x25, 0x55, 0xa8, 0xb8, 0x19, 0x8c,
-	0xee, 0xc3, 0x06, 0xc3, 0x83, 0x28, 0x4e, 0x5f, 0x7b, 0x14, 0x0f, 0xfc, 0x97, 0x78, 0x20, 0x55,
-	0xa9, 0xb8, 0xeb, 0x1a, 0xed, 0x2a, 0x2c, 0xfa, 0x14, 0x6a, 0x09, 0x25, 0x89, 0xdf, 0xf7, 0x85,
-	0xf3, 0x64, 0xf7, 0xb1, 0xde, 0xde, 0xd1, 0xfe, 0x91, 0xba, 0x76, 0x47, 0xc3, 0xae, 0x4d, 0xeb,
-	0xfc, 0x16, 0x36, 0x8e, 0xfd, 0x21, 0x66, 0x89, 0x1f, 0x88, 0x45, 0x14, 0x91, 0x58, 0xac, 0x34,
-	0xa9, 0x6f, 0x8c, 0xf9, 0x0f, 0x84, 0xbe, 0x92, 0x46, 0x55, 0xdc, 0x9a, 0xc0, 0x1d, 0x2b, 0x14,
-	0xda, 0x85, 0x8a, 0x32, 0x49, 0x2f, 0xdb, 0x8a, 0x2b, 0x9d, 0xd5, 0x8d, 0xc2, 0x6c, 0x28, 0x4a,
-	0x02, 0x6d, 0xd0, 0xaa, 0x72, 0x5c, 0xe0, 0x38, 0x00, 0x9d, 0x98, 0xff, 0xfa, 0xe3, 0x6f, 0x44,
-	0x0a, 0x8d, 0x12, 0x4b, 0xc8, 0x2f, 0xe9, 0xc4, 0x72, 0x7c, 0xa8, 0x9f, 0x3e, 0x3b, 0x14, 0xc6,
-	0x69, 0x6d, 0x10, 0x2c, 0x8b, 0x46, 0xd4, 0x34, 0x16, 0xe2, 0x5b, 0xe0, 0x28, 0x19, 0x64, 0xbb,
-	0x9b, 0xf8, 0x16, 0x38, 0x7e, 0x95, 0x64, 0x35, 0x43, 0x7c, 0x8b, 0x29, 0x06, 0xf8, 0x52, 0xbb,
-	0xad, 0xea, 0x2a, 0xc0, 0xf9, 0x43, 0x09, 0x6e, 0xca, 0x19, 0x4e, 0xfd, 0x38, 0x7c, 0x49, 0x5e,
-	0x9f, 0xe2, 0x20, 0xa5, 0x11, 0xbf, 0x12, 0x6b, 0x01, 0xbf, 0xe6, 0xe8, 0x00, 0x36, 0x63, 0xe3,
-	0x12, 0xcf, 0xa4, 0xa7, 0x98, 0xbe, 0xd6, 0xbe, 0xa1, 0x7d, 0x3a, 0xe6, 0x32, 0xb7, 0x11, 0xe7,
-	0x11, 0x0c, 0x3d, 0x19, 0xc5, 0xce, 0x88, 0x28, 0x4a, 0x11, 0xdb, 0x5a, 0x44, 0xce, 0xca, 0x2c,
-	0xa2, 0x86, 0xfd, 0x43, 0xa8, 0xd1, 0x34, 0xf6, 0x7c, 0xe6, 0x49, 0xe3, 0x4b, 0x92, 0x75, 0x53,
-	0xb3, 0x8e, 0x9c, 0xe8, 0x56, 0x69, 0x1a, 0xef, 0xb3, 0x73, 0xe1, 0x94, 0xfb, 0xb0, 0x61, 0x32,
-	0xc7, 0xa3, 0x84, 0xf0, 0x1e, 0x33, 0xd9, 0x62, 0xd0, 0xae, 0xc4, 0xa2, 0xf7, 0x61, 0x8b, 0xa5,
-	0x49, 0x32, 0xc0, 0x43, 0x1c, 0x73, 0x7f, 0xe0, 0xf5, 0x29, 0x49, 0x13, 0xd6, 0x2c, 0xdf, 0x29,
-	0x3d, 0x28, 0xb9, 0xc8, 0x1e, 0x7a, 0x21, 0x47, 0xd0, 0xdb, 0x00, 0x09, 0x8d, 0x2e, 0xa3, 0x01,
-	0xee, 0xe3, 0xb0, 0xb9, 0x22, 0x85, 0x5a, 0x18, 0xf4, 0x01, 0x6c, 0x33, 0x1c, 0x04, 0x64, 0x98,
-	0x78, 0x09, 0x25, 0xa2, 0xf5, 0x53, 0xb9, 0xbe, 0x2a, 0xbd, 0x8e, 0xf4, 0x58, 0x57, 0x0d, 0x89,
-	0xac, 0x77, 0xfe, 0x54, 0x14, 0x55, 0x32, 0x4e, 0x5f, 0x77, 0x49, 0xa8, 0xa3, 0xa0, 0xeb, 0xc8,
-	0x3d, 0xa8, 0x07, 0x52, 0x21, 0x4f, 0x54, 0xef, 0xac, 0x50, 0xaf, 0x29, 0x64, 0x57, 0xe2, 0xd0,
-	0x11, 0x34, 0x98, 0x0e, 0x9a, 0xa8, 0xd6, 0x22, 0x6a, 0xda, 0xbb, 0x4e, 0x56, 0x35, 0x67, 0xc6,
-	0xd7, 0xdd, 0x60, 0x13, 0x01, 0x5f, 0x65, 0x57, 0x2c, 0xe0, 0x03, 0x55, 0x85, 0x6a, 0xed, 0x9f,
-	0xdb, 0x52, 0xc6, 0x55, 0xdc, 0x3b, 0x55, 0xb4, 0xaa, 0xee, 0x1a, 0xce, 0xd6, 0x67, 0xb0, 0x66,
-	0x0f, 0x2c, 0xd4, 0x34, 0x51, 0x40, 0xa3, 0x59, 0x8e, 0xc6, 0x7b, 0xb8, 0x82, 0xb5, 0x0b, 0xea,
-	0x63, 0x88, 0x6e, 0xa9, 0xc5, 0x31, 0xe4, 0x16, 0x54, 0xb3, 0xe4, 0xd3, 0xc9, 0x3f, 0x42, 0x88,
-	0x02, 0xeb, 0x73, 0x8e, 0x87, 0x09, 0xd7, 0x5b, 0x94, 0x01, 0x9d, 0xbf, 0x2d, 0x43, 0x63, 0xc2,
-	0xfb, 0x9f, 0xe4, 0x9a, 0x50, 0xe1, 0xd0, 0x5d, 0x53, 0x65, 0x27, 0xf4, 0xb3, 0xba, 0xcd, 0x96,
-	0x5a, 0xf3, 0xf6, 0xe1, 0xd1, 0xc0, 0x22, 0xa0, 0x03, 0xd2, 0xf7, 0xc2, 0x88, 0xe2, 0x80, 0x13,
-	0x7a, 0xa5, 0x75, 0x5c, 0x1b, 0x90, 0xfe, 0x53, 0x83, 0x43, 0xef, 0x03, 0x84, 0x31, 0x93, 0x3b,
-	0x6f, 0xd4, 0x97, 0x9a, 0xd6, 0xda, 0x0d, 0x3d, 0x73, 0xb6, 0xc7, 0xb8, 0xd5, 0x30, 0x66, 0x5a,
-	0xd1, 0x47, 0x50, 0x17, 0x55, 0xdb, 0x1b, 0xaa, 0xed, 0x41, 0x65, 0x6f, 0xad, 0x8d, 0x32, 0x6d,
-	0xb3, 0x9d, 0xc3, 0x5d, 0x4b, 0x46, 0x00, 0x43, 0x9f, 0xc3, 0x8a, 0xac, 0x99, 0xac, 0xb9, 0x22,
-	0x39, 0xee, 0x4d, 0xd8, 0xa7, 0xa3, 0x7c, 0x28, 0xa9, 0x54, 0x90, 0x35, 0x0b, 0xfa, 0x0d, 0xd4,
-	0xfc, 0x38, 0x26, 0xdc, 0x57, 0x0b, 0x7a, 0x55, 0x4a, 0x78, 0x30, 0x4b, 0xc2, 0xfe, 0x88, 0x54,
-	0x89, 0xb1, 0x99, 0x51, 0x1b, 0xca, 0x72, 0xc5, 0x37, 0x2b, 0xd2, 0xda, 0x5b, 0x6f, 0x4a, 0x39,
-	0x57, 0x91, 0xb6, 0x3e, 0x85, 0x9a, 0xa5, 0xd6, 0x22, 0x29, 0xd6, 0xfa, 0x02, 0x1a, 0xe3, 0xfa,
-	0x2c, 0x94, 0xa2, 0x77, 0xa1, 0x2a, 0x8f, 0x90, 0xa7, 0x09, 0x0e, 0xa6, 0x77, 0x71, 0xce, 0x27,
-	0x50, 0x93, 0x24, 0xcf, 0xa3, 0x01, 0xc7, 0x14, 0xfd, 0xcc, 0x26, 0x1a, 0x85, 0x33, 0x93, 0x62,
-	0xd8, 0xce, 0x61, 0x53, 0xf4, 0x38, 0x12, 0x6f, 0x9a, 0x25, 0xf4, 0x10, 0x56, 0x7a, 0x52, 0x8c,
-	0xe6, 0x46, 0x36, 0xb7, 0x9a, 0xc0, 0xd5, 0x14, 0x42, 0x9b, 0xe0, 0x02, 0x07, 0xaf, 0x4c, 0x87,
-	0x22, 0x01, 0xe7, 0x1f, 0x05, 0xa8, 0x59, 0x17, 0x27, 0x72, 0x7f, 0xc0, 0x8c, 0xeb, 0xee, 0x44,
-	0x7e, 0x8b, 0xbc, 0x8d, 0x62, 0x8e, 0xe9, 0xa5, 0x3f, 0x90, 0xcc, 0x25, 0x37, 0x83, 0xc5, 0xca,
-	0xe1, 0xd1, 0x10, 0x93, 0x54, 0xb5, 0x06, 0x25, 0xd7, 0x80, 0xaa, 0x13, 0xf5, 0x29, 0xf7, 0x12,
-	0x4c, 0x23, 0xa2, 0x6e, 0x00, 0x4a, 0xa2, 0x13, 0xf5, 0x29, 0xef, 0x4a, 0x94, 0x60, 0xa6, 0x98,
-	0xd3, 0x08, 0x33, 0xb9, 0x19, 0x97, 0x5d, 0x03, 0xa2, 0x87, 0xb0, 0x89, 0x5f, 0x47, 0xdc, 0x23,
-	0xb1, 0x97, 0xc6, 0x17, 0x52, 0xbf, 0x2b, 0x5d, 0x52, 0x37, 0xc4, 0xc0, 0x49, 0x7c, 0x6e, 0xd0,
-	0xce, 0xdf, 0x8b, 0x50, 0xee, 0x58, 0x0d, 0xf2, 0xe8, 0x90, 0x7c, 0x13, 0xaa, 0x14, 0x27, 0xc4,
-	0xe3, 0x7e, 0x3f, 0x6b, 0xaa, 0x04, 0xe2, 0xcc, 0xef, 0x33, 0xa1, 0x9f, 0x1c, 0x0c, 0xa3, 0x3e,
-	0x66, 0xdc, 0x74, 0x56, 0x35, 0x81, 0x7b, 0xaa, 0x50, 0xc2, 0x19, 0x2c, 0xfa, 0x3d, 0x96, 0xaa,
-	0x2f, 0xbb, 0xf2, 0x1b, 0xdd, 0x53, 0xa5, 0xa5, 0x3c, 0x6b, 0xab, 0x91, 0xd5, 0xc6, 0xbe, 0x26,
-	0x5a, 0x19, 0xbb, 0x26, 0x6a, 0xc2, 0x6a, 0x40, 0xb1, 0xcf, 0x71, 0xa8, 0x2b, 0xbf, 0x01, 0x45,
-	0x43, 0x3a, 0x20, 0x7e, 0x88, 0x43, 0x99, 0xec, 0x55, 0x57, 0x43, 0xe8, 0x5d, 0x58, 0x66, 0x09,
-	0x0e, 0x9a, 0xd5, 0x19, 0x19, 0x22, 0x47, 0xd1, 0xc7, 0x50, 0x53, 0x1e, 0x51, 0x51, 0x86, 0x5c,
-	0x42, 0xd8, 0x77, 0x63, 0x36, 0x99, 0xf3, 0x12, 0x90, 0x9d, 0x56, 0xba, 0xd3, 0x7e, 0x17, 0x56,
-	0x64, 0xd6, 0x99, 0x2e, 0x7b, 0xcd, 0x9e, 0xd3, 0xd5, 0x63, 0x73, 0x1f, 0x2f, 0xbf, 0x01, 0xa4,
-	0x94, 0xb5, 0x6f, 0x1f, 0xe6, 0x4d, 0x7c, 0xe1, 0xaf, 0x4b, 0x4c, 0x5f, 0x12, 0x66, 0x7a, 0x6b,
-	0x03, 0x3a, 0xff, 0x2d, 0xc0, 0x56, 0x4e, 0xb0, 0xd6, 0xde, 0xc9, 0x4b, 0xce, 0x2b, 0xaf, 0xa5,
-	0x3e, 0x86, 0xe5, 0x28, 0xee, 0x11, 0x99, 0x15, 0xb5, 0xf6, 0xbb, 0xb9, 0xc9, 0x73, 0xd2, 0xf6,
-	0x3a, 0x71, 0x8f, 0xa8, 0xc2, 0x24, 0x39, 0xe6, 0xbe, 0x1f, 0x78, 0x04, 0xd5, 0x8c, 0x75, 0xa1,
-	0x1a, 0xf2, 0x19, 0x34, 0xa4, 0x1e, 0x82, 0x7b, 0x41, 0x67, 0x39, 0x27, 0xb0, 0x69, 0xf1, 0x6a,
-	0x7f, 0x20, 0x9d, 0x3f, 0x7a, 0x87, 0x94, 0xd9, 0x32, 0x6f, 0xec, 0xfe, 0x55, 0x00, 0xd8, 0x4f,
-	0xf9, 0x85, 0xde, 0x50, 0xec, 0xc4, 0x2e, 0xbc, 0xe1, 0xfe, 0xb3, 0x98, 0xbf, 0xff, 0x14, 0x2a,
-	0xf8, 0x29, 0xbf, 0x30, 0x6d, 0xa7, 0xf8, 0x16, 0xe7, 0x02, 0x75, 0xf8, 0xf1, 0xfc, 0x30, 0xa4,
-	0x98, 0x31, 0xdd, 0x7f, 0xd6, 0x15, 0x76, 0x5f, 0x21, 0x05, 0x59, 0x14, 0xe2, 0x98, 0x8b, 0x2e,
-	0x86, 0x93, 0x57, 0x58, 0x35, 0xee, 0x55, 0xb7, 0x6e, 0xb0, 0x67, 0x02, 0x29, 0xc8, 0x28, 0xee,
-	0x47, 0x8c, 0x53, 0x43, 0xa6, 0x16, 0x5e, 0xdd, 0x60, 0x25, 0x99, 0xf3, 0x97, 0x02, 0x34, 0xba,
-	0xe9, 0x60, 0x90, 0xbb, 0x22, 0x9c, 0x37, 0x15, 0xdf, 0xd3, 0x56, 0x14, 0x73, 0x8b, 0x7f, 0xe4,
-	0x1e, 0x6d, 0xd8, 0x17, 0xb0, 0xce, 0xd4, 0xbe, 0x64, 0xb6, 0x6a, 0xd5, 0x98, 0xee, 0xcc, 0xd8,
-	0x02, 0xdd, 0x3a, 0xb3, 0x41, 0xe7, 0x5b, 0xd8, 0xb4, 0x54, 0xd4, 0x41, 0xbc, 0x09, 0xea, 0x94,
-	0xed, 0x51, 0xdc, 0x33, 0xae, 0x8f, 0x14, 0x45, 0x6f, 0xee, 0x68, 0xba, 0x80, 0xd4, 0x65, 0xc5,
-	0x4f, 0x32, 0x7f, 0xfa, 0x9d, 0xcf, 0x13, 0xd8, 0xca, 0xc9, 0x5c, 0xf0, 0x16, 0x64, 0x5b, 0x17,
-	0x87, 0xe7, 0xcc, 0xca, 0x77, 0xe7, 0x1e, 0xd4, 0xce, 0x67, 0x1d, 0x82, 0x96, 0xcd, 0x21, 0xe8,
-	0x3e, 0x6c, 0x9e, 0xaa, 0x7b, 0x8d, 0x8e, 0x4c, 0x85, 0x5e, 0xa4, 0x0e, 0x3d, 0x69, 0x9a, 0xed,
-	0x02, 0xf2, 0xdb, 0xf9, 0x4f, 0x01, 0x36, 0x9e, 0x47, 0x03, 0xcc, 0xae, 0x18, 0xc7, 0x43, 0x79,
-	0x73, 0x26, 0x1a, 0x42, 0xb1, 0x53, 0x31, 0xee, 0x0f, 0x13, 0x7d, 0xb6, 0x1a, 0x21, 0xd0, 0x23,
-	0x00, 0x73, 0x8d, 0xa2, 0xfb, 0xc8, 0x5a, 0xbb, 0x69, 0x8e, 0x24, 0xe3, 0x73, 0xba, 0x55, 0x66,
-	0x50, 0xe8, 0x43, 0x80, 0x94, 0xe1, 0xd0, 0x7b, 0x79, 0xc5, 0x31, 0xd3, 0x71, 0x37, 0x45, 0xf8,
-	0xdc, 0x3e, 0x91, 0x08, 0xaa, 0xaf, 0x04, 0x11, 0xfa, 0x08, 0x6a, 0x51, 0x4c, 0x42, 0x2c, 0x0f,
-	0x31, 0xa1, 0x6e, 0xeb, 0xa6, 0xf1, 0x80, 0x22, 0x3b, 0x67, 0x38, 0x74, 0x7e, 0x34, 0xb5, 0xcf,
-	0xf8, 0x4d, 0xbb, 0xfd, 0x00, 0x36, 0x55, 0x9a, 0xf4, 0x32, 0x7b, 0x4d, 0x11, 0x37, 0xa7, 0xb2,
-	0x31, 0x4f, 0xb8, 0x8d, 0x48, 0x77, 0x0b, 0x86, 0x7e, 0xee, 0x74, 0x7a, 0x05, 0x1b, 0x67, 0x7e,
-	0x3f, 0x97, 0x4b, 0x0f, 0x61, 0x95, 0xd1, 0xe0, 0xd8, 0xd4, 0x87, 0x69, 0xd9, 0x64, 0x08, 0xd0,
-	0x2f, 0xa1, 0x12, 0x62, 0xc6, 0x8f, 0x4d, 0x3f, 0x3c, 0x8d, 0x38, 0xa3, 0x10, 0x65, 0x71, 0x34,
-	0xd9, 0x62, 0x49, 0xf6, 0xf0, 0x16, 0x54, 0xcc, 0xfd, 0x07, 0x5a, 0x85, 0xd2, 0xd9, 0x41, 0xb7,
-	0xb1, 0x24, 0x3e, 0xce, 0x9f, 0x76, 0x1b, 0x85, 0x87, 0x43, 0x68, 0x8c, 0x9f, 0xfe, 0xd1, 0x0e,
-	0x6c, 0x75, 0xdd, 0x93, 0xee, 0xfe, 0x8b, 0xfd, 0xb3, 0xce, 0xc9, 0xb1, 0xd7, 0x75, 0x3b, 0xdf,
-	0xec, 0x9f, 0x3d, 0x6b, 0x2c, 0xa1, 0xbb, 0x70, 0xdb, 0x1e, 0xf8, 0xfa, 0xe4, 0xf4, 0xcc, 0x3b,
-	0x3b, 0xf1, 0x0e, 0x4e, 0x8e, 0xcf, 0xf6, 0x3b, 0xc7, 0xcf, 0xdc, 0x46, 0x01, 0xdd, 0x86, 0x5d,
-	0x9b, 0xe4, 0xab, 0xce, 0xd3, 0x8e, 0xfb, 0xec, 0x40, 0x7c, 0xef, 0x1f, 0x36, 0x8a, 0xed, 0xbf,
-	0xae, 0xc1, 0x9a, 0x32, 0x10, 0xd3, 0xcb, 0x28, 0x10, 0x31, 0x83, 0xd1, 0x1e, 0x8c, 0x9a, 0xd6,
-	0x8d, 0x56, 0xae, 0xdb, 0x6b, 0xed, 0x4e, 0x19, 0x51, 0x8e, 0x70, 0x96, 0xd0, 0x73, 0xdd, 0x56,
-	0xaa, 0xdd, 0x0b, 0xed, 0x4e, 0xdb, 0xd1, 0x94, 0x98, 0xd6, 0xec, 0xcd, 0xce, 0x59, 0x42, 0x5f,
-	0xea, 0x0e, 0x56, 0x64, 0x15, 0xda, 0xb1, 0x49, 0xad, 0xf5, 0xd9, 0x6a, 0x4e, 0x0e, 0xd8, 0x12,
-	0xb2, 0xf2, 0x95, 0x49, 0x18, 0xaf, 0xb9, 0x99, 0x84, 0x89, 0x4a, 0xa7, 0x6c, 0xb1, 0x4a, 0x4a,
-	0x66, 0xcb, 0x64, 0xe9, 0xca, 0x6c, 0x99, 0x52, 0x81, 0x2c, 0x9f, 0xa8, 0x35, 0x92, 0xf7, 0x49,
-	0xae, 0xde, 0xe4, 0x7d, 0x92, 0x5f, 0x52, 0xce, 0x12, 0xda, 0x87, 0x6a, 0xf6, 0x38, 0x94, 0x59,
-	0x34, 0xfe, 0xd0, 0x34, 0x0a, 0xcf, 0xc4, 0x3b, 0x92, 0xb3, 0x84, 0x1e, 0xc3, 0x8a, 0x7a, 0xd2,
-	0x41, 0xdb, 0xd9, 0x54, 0xd6, 0xc3, 0x50, 0xeb, 0xc6, 0x18, 0x76, 0xc4, 0x79, 0x02, 0xeb, 0xf9,
-	0xcb, 0x4d, 0x74, 0x6b, 0xc6, 0x9d, 0xa7, 0x92, 0x74, 0xfb, 0x8d, 0x37, 0xa2, 0xce, 0x12, 0x3a,
-	0x87, 0xc6, 0xf8, 0xf5, 0x2f, 0x7a, 0x5b, 0x33, 0xcd, 0xb8, 0x90, 0x6e, 0xbd, 0x33, 0x73, 0x3c,
-	0x13, 0xeb, 0x5a, 0xd7, 0xa4, 0x2a, 0x1c, 0xe8, 0xf6, 0x38, 0x57, 0xee, 0x72, 0xbd, 0xf5, 0xf6,
-	0xac, 0xe1, 0x4c, 0xe6, 0x09, 0xac, 0xe7, 0xaf, 0xf6, 0x33, 0xdb, 0xa7, 0xbe, 0x11, 0xb4, 0x6e,
-	0xcf, 0x18, 0x9d, 0xaa, 0xa4, 0x7a, 0xa0, 0x98, 0x54, 0x32, 0xf7, 0x02, 0x32, 0xa9, 0x64, 0xfe,
-	0x5d, 0x63, 0x4c, 0xa6, 0x7a, 0xb7, 0x9c, 0x94, 0x99, 0x7b, 0x56, 0x9d, 0x94, 0x99, 0x7f, 0xee,
-	0x1c, 0x8b, 0x91, 0x7e, 0xeb, 0x99, 0x8c, 0x51, 0xfe, 0xe1, 0x68, 0x32, 0x46, 0x63, 0x8f, 0x44,
-	0xce, 0x12, 0xfa, 0x16, 0x36, 0x27, 0x5e, 0xfc, 0xd0, 0x3b, 0x93, 0x0f, 0x68, 0xf9, 0x82, 0x71,
-	0x67, 0x36, 0x41, 0x26, 0xf9, 0x7b, 0xd8, 0x9a, 0xf2, 0x00, 0x87, 0xee, 0xbe, 0xe9, 0x71, 0x4e,
-	0x49, 0x77, 0xae, 0x7f, 0xbf, 0x73, 0x96, 0xd0, 0xc7, 0x50, 0x96, 0x0f, 0xe8, 0x68, 0x2b, 0x5b,
-	0x65, 0xa3, 0xe7, 0xfa, 0xd6, 0x76, 0x1e, 0x99, 0x71, 0x3d, 0x82, 0x15, 0xf5, 0x28, 0x8e, 0x2c,
-	0x8a, 0xd1, 0x63, 0x7a, 0xeb, 0xad, 0x31, 0xac, 0x5d, 0x39, 0xec, 0x53, 0xf1, 0xee, 0x94, 0x63,
-	0xd4, 0x58, 0xe5, 0x98, 0xf2, 0xef, 0x03, 0x67, 0x09, 0x3d, 0x81, 0x8a, 0xd9, 0xb4, 0x90, 0x59,
-	0xe2, 0x63, 0x5b, 0x66, 0x6b, 0x67, 0x02, 0x6f, 0xd8, 0x5f, 0xae, 0xc8, 0xbb, 0xfa, 0x8f, 0xfe,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xf3, 0xc7, 0xd9, 0x14, 0x21, 0x00, 0x00,
+	proto.RegisterFile("isula/isula_image.proto", fileDescriptor_isula_image_2aa07529653b62c7)
+}
+
+var fileDescriptor_isula_image_2aa07529653b62c7 = []byte{
+	// 4447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0x4d, 0x6c, 0x1b, 0xc9,
+	0x72, 0xb0, 0xf9, 0x27, 0x91, 0x45, 0x91, 0x22, 0x5b, 0xb2, 0x45, 0x8d, 0x7f, 0x77, 0xec, 0x7d,
+	0xfe, 0x79, 0x6f, 0xb5, 0x5e, 0xbd, 0xdd, 0xcf, 0xde, 0xdd, 0xcf, 0x9b, 0xa7, 0x95, 0xec, 0x7d,
+	0x4c, 0x6c, 0x8b, 0x19, 0x49, 0x9b, 0x07, 0x3c, 0x20, 0x83, 0x31, 0xa7, 0x49, 0xcd, 0xf3, 0x70,
+	0x66, 0x76, 0x66, 0x28, 0x8b, 0x0f, 0x08, 0x10, 0x3c, 0x24, 0x39, 0x3d, 0x20, 0xc7, 0x00, 0x39,
+	0xe7, 0x10, 0x04, 0xb9, 0x05, 0x48, 0x2e, 0x39, 0xe4, 0x1e, 0x04, 0x48, 0x80, 0x5c, 0x73, 0xcb,
+	0x3d, 0xa7, 0x5c, 0x72, 0x0a, 0xba, 0xfa, 0x67, 0x7a, 0xf8, 0x23, 0x8b, 0x06, 0x72, 0x91, 0xa6,
+	0xab, 0xaa, 0xab, 0xab, 0xab, 0xab, 0xab, 0xaa, 0xab, 0x9b, 0xb0, 0xe5, 0x25, 0x63, 0xdf, 0xf9,
+	0x14, 0xff, 0xda, 0xde, 0xc8, 0x19, 0xd2, 0x9d, 0x28, 0x0e, 0xd3, 0x90, 0x54, 0x10, 0x64, 0x6e,
+	0x02, 0xf9, 0x39, 0x75, 0xfc, 0xf4, 0x74, 0xff, 0x94, 0xf6, 0xdf, 0x5a, 0xf4, 0x87, 0x31, 0x4d,
+	0x52, 0xf3, 0x19, 0x6c, 0xe4, 0xa0, 0x49, 0x14, 0x06, 0x09, 0x25, 0xd7, 0x60, 0x85, 0xc6, 0xf1,
+	0x28, 0x19, 0x76, 0x0a, 0x77, 0x0a, 0x0f, 0x6a, 0x96, 0x68, 0x91, 0x26, 0x14, 0xfb, 0xfd, 0x4e,
+	0xf1, 0x4e, 0xe1, 0x41, 0xc3, 0x2a, 0xf6, 0xfb, 0xe6, 0xbf, 0x15, 0x60, 0xed, 0x65, 0x38, 0xf4,
+	0x02, 0xc1, 0x8f, 0x75, 0x4c, 0x68, 0x7c, 0x46, 0x63, 0xd9, 0x91, 0xb7, 0x88, 0x01, 0xd5, 0x71,
+	0x42, 0xe3, 0xc0, 0x19, 0x51, 0xec, 0x5e, 0xb3, 0x54, 0x9b, 0xe1, 0x22, 0x27, 0x49, 0xde, 0x85,
+	0xb1, 0xdb, 0x29, 0x71, 0x9c, 0x6c, 0x93, 0x8f, 0xa1, 0xe9, 0xb9, 0x34, 0x48, 0xbd, 0x74, 0x62,
+	0xa7, 0xe1, 0x5b, 0x1a, 0x74, 0xca, 0x48, 0xd1, 0x90, 0xd0, 0x63, 0x06, 0x24, 0x77, 0xa1, 0x11,
+	0xd3, 0x41, 0x4c, 0x93, 0x53, 0x41, 0x55, 0x41, 0xaa, 0x35, 0x01, 0xe4, 0x44, 0x1f, 0x43, 0x33,
+	0xa6, 0x43, 0x2f, 0x49, 0x63, 0xc9, 0x6b, 0x85, 0xf3, 0x92, 0x50, 0x24, 0x33, 0x9f, 0x40, 0x43,
+	0x4c, 0x69, 0x49, 0x65, 0xdc, 0xc7, 0x8e, 0xe1, 0x38, 0x7d, 0x8f, 0x32, 0xcc, 0xa7, 0xd0, 0x94,
+	0x84, 0x4b, 0x0e, 0x71, 0x02, 0x35, 0x94, 0xad, 0x1b, 0x0c, 0xc2, 0x0f, 0xd2, 0xf5, 0x26, 0x54,
+	0x92, 0x34, 0x8c, 0xa9, 0x50, 0x34, 0x6f, 0x98, 0x1b, 0xd0, 0x7e, 0xe9, 0x25, 0x29, 0xb2, 0x4e,
+	0xa4, 0x69, 0x0c, 0x80, 0xe8, 0x40, 0x21, 0xe9, 0x03, 0x58, 0xf1, 0x11, 0xd2, 0x29, 0xdc, 0x29,
+	0x3d, 0xa8, 0xef, 0xb6, 0x76, 0xd0, 0xbc, 0x76, 0x94, 0x58, 0x96, 0xc0, 0x6b, 0x73, 0x2a, 0xce,
+	0x99, 0x53, 0x49, 0xcd, 0x89, 0x40, 0x8b, 0x6b, 0x63, 0xcf, 0xf7, 0xe5, 0xd8, 0x27, 0xd0, 0xd6,
+	0x60, 0x62, 0xe8, 0x0e, 0xac, 0xf2, 0x19, 0xf2, 0xb1, 0x6b, 0x96, 0x6c, 0x5e, 0x7a, 0xa8, 0x6b,
+	0xb0, 0x69, 0x85, 0xa9, 0x93, 0xd2, 0xbd, 0x71, 0x7a, 0xfa, 0x7b, 0x74, 0x22, 0x87, 0xfb, 0x1d,
+	0xb8, 0x3a, 0x05, 0x5f, 0x72, 0x5d, 0xfe, 0xb4, 0x00, 0xd7, 0xf6, 0xc3, 0x20, 0x75, 0xbc, 0x80,
+	0xc6, 0xcf, 0xcf, 0xa3, 0x30, 0x56, 0x46, 0xb0, 0x05, 0xab, 0x4c, 0xf3, 0xb6, 0xe7, 0x4a, 0x1e,
+	0xac, 0xd9, 0x75, 0x19, 0xef, 0x70, 0x9c, 0x46, 0xe3, 0x54, 0x0a, 0xcd, 0x5b, 0xa4, 0x05, 0xa5,
+	0xb1, 0xe7, 0x0a, 0xa9, 0xd9, 0x27, 0x83, 0x0c, 0x3d, 0x17, 0x2d, 0xbf, 0x61, 0xb1, 0x4f, 0xec,
+	0x3b, 0x18, 0x24, 0x34, 0x45, 0x43, 0x6f, 0x58, 0xa2, 0x65, 0xee, 0xc1, 0xd6, 0x8c, 0x18, 0x4b,
+	0x4e, 0xe5, 0x29, 0x5b, 0x0e, 0xc7, 0xed, 0x32, 0x0f, 0x22, 0xe7, 0x40, 0xa0, 0x3c, 0xf0, 0x7c,
+	0x2a, 0x7a, 0xe2, 0x37, 0x13, 0x2a, 0x75, 0xa4, 0xc2, 0xd9, 0xa7, 0x79, 0x0c, 0x44, 0xeb, 0xc9,
+	0x86, 0x0d, 0xf9, 0xb8, 0xe1, 0x38, 0xd5, 0xc6, 0xe5, 0xad, 0x4b, 0xaf, 0xd9, 0x17, 0xd0, 0xe8,
+	0x8e, 0x74, 0x85, 0x5e, 0x4e, 0x98, 0x2e, 0xac, 0xcb, 0x6e, 0x52, 0x92, 0x26, 0x14, 0xd5, 0x22,
+	0x14, 0xb9, 0x12, 0x2f, 0x25, 0x81, 0x01, 0x9d, 0xef, 0x62, 0x27, 0x3a, 0x75, 0x63, 0xef, 0x8c,
+	0xc6, 0x47, 0xa9, 0x93, 0x8e, 0xd5, 0x26, 0xf9, 0x23, 0x68, 0x1c, 0x9e, 0xd1, 0xd8, 0x77, 0x26,
+	0x1c, 0x4e, 0x7e, 0x04, 0xeb, 0xc9, 0x38, 0x62, 0x03, 0x27, 0xb6, 0x6b, 0xa7, 0x93, 0x88, 0x0b,
+	0x5a, 0xb5, 0x1a, 0x12, 0x7c, 0x70, 0x3c, 0x89, 0x28, 0xb9, 0x0d, 0xf5, 0xc0, 0x49, 0xbd, 0x33,
+	0x6a, 0xbb, 0xde, 0x60, 0x80, 0x12, 0x54, 0x2d, 0xe0, 0xa0, 0x03, 0x6f, 0x30, 0x60, 0x2e, 0x2d,
+	0x8a, 0xc3, 0x5f, 0xd1, 0x7e, 0x6a, 0xff, 0x30, 0x0e, 0x53, 0x07, 0x05, 0xaa, 0x5a, 0x6b, 0x02,
+	0xf8, 0xfb, 0x0c, 0x66, 0xfe, 0x43, 0x05, 0xc8, 0x01, 0x3d, 0xf3, 0xfa, 0x74, 0xe4, 0x44, 0x91,
+	0x14, 0x8e, 0x5c, 0x87, 0x5a, 0x14, 0x86, 0xbe, 0x8d, 0x2e, 0xa0, 0x20, 0x5c, 0x6a, 0x18, 0xfa,
+	0xaf, 0x99, 0x0b, 0xb8, 0x0e, 0x35, 0xd7, 0x49, 0x1d, 0x1b, 0x95, 0x28, 0xfc, 0x03, 0x03, 0xbc,
+	0x60, 0x8a, 0xbc, 0x0b, 0x8d, 0x11, 0x4d, 0x9d, 0x8c, 0x80, 0xfb, 0x89, 0x35, 0x09, 0x44, 0xa2,
+	0x7b, 0xd0, 0x44, 0x02, 0x3f, 0x0c, 0x23, 0x4e, 0xc5, 0x9d, 0xf2, 0x1a, 0x83, 0xbe, 0x0c, 0xc3,
+	0x08, 0xa9, 0x7e, 0x02, 0x44, 0xb1, 0xca, 0x28, 0xb9, 0x63, 0x6e, 0x49, 0x8c, 0xa2, 0x96, 0x52,
+	0x8d, 0x13, 0xea, 0xa2, 0x5f, 0x2e, 0x73, 0xa9, 0x4e, 0x12, 0xea, 0x92, 0x9b, 0x00, 0x88, 0x4c,
+	0xc3, 0xd4, 0xf1, 0x3b, 0xab, 0x88, 0x45, 0xf2, 0x63, 0x06, 0x60, 0x8e, 0x1d, 0xd1, 0xce, 0x99,
+	0xe3, 0xf9, 0xce, 0x1b, 0x9f, 0x76, 0xaa, 0x48, 0xd2, 0x60, 0xd0, 0x3d, 0x09, 0xcc, 0xcd, 0x0d,
+	0x87, 0xa9, 0x21, 0x95, 0x9a, 0x1b, 0x0e, 0xf5, 0x31, 0x34, 0x15, 0x11, 0x1f, 0x0e, 0x38, 0x2f,
+	0x09, 0xe5, 0x43, 0x7e, 0xa2, 0x4d, 0x2e, 0x1b, 0xb6, 0x8e, 0xa4, 0x6d, 0x89, 0xc9, 0x86, 0xbe,
+	0x07, 0xcd, 0x91, 0x17, 0xd8, 0x83, 0x98, 0x52, 0x3b, 0x89, 0x9c, 0x3e, 0xed, 0xac, 0x89, 0xb1,
+	0xbd, 0xe0, 0x45, 0x4c, 0xe9, 0x11, 0x83, 0x91, 0x07, 0xd0, 0x7a, 0xe3, 0x24, 0xd4, 0x76, 0x71,
+	0x45, 0xed, 0xc4, 0xfb, 0x35, 0xed, 0x34, 0x90, 0xae, 0xc9, 0xe0, 0x7c, 0xa1, 0x8f, 0xbc, 0x5f,
+	0x53, 0xb2, 0x03, 0x1b, 0x63, 0x97, 0x9e, 0xd9, 0xc9, 0x24, 0xe8, 0xdb, 0xc2, 0xb0, 0xa8, 0xdb,
+	0x69, 0xa2, 0x89, 0xb4, 0x19, 0xea, 0x68, 0x12, 0xf4, 0x8f, 0x24, 0x82, 0x3c, 0x84, 0x96, 0x4b,
+	0x07, 0x34, 0x8e, 0xa9, 0x6b, 0xc7, 0x74, 0x14, 0x9e, 0x39, 0x7e, 0x67, 0x1d, 0x89, 0xd7, 0x25,
+	0xdc, 0xe2, 0x60, 0xf2, 0x63, 0x68, 0x2b, 0x52, 0x97, 0xfa, 0x34, 0xf5, 0xc2, 0xa0, 0xd3, 0x42,
+	0x5a, 0xc5, 0xe3, 0x40, 0xc0, 0xc9, 0x53, 0xe8, 0xe4, 0x89, 0xf1, 0x3f, 0x13, 0x33, 0xe9, 0xb4,
+	0x71, 0x03, 0x5d, 0xcb, 0xf5, 0x61, 0xff, 0x10, 0x6b, 0xfe, 0x7d, 0x11, 0xb6, 0xe7, 0xec, 0xaa,
+	0xcc, 0x59, 0x25, 0x08, 0x51, 0xa1, 0x0d, 0x5b, 0x97, 0xdd, 0xb2, 0x6c, 0x77, 0x71, 0xbe, 0x7c,
+	0x0b, 0x70, 0xf3, 0x04, 0x0e, 0xc2, 0x4d, 0xf0, 0x09, 0x90, 0x37, 0x4e, 0xff, 0xad, 0x17, 0x0c,
+	0xd1, 0x2c, 0x93, 0x49, 0x92, 0xd2, 0x91, 0x30, 0xce, 0xb6, 0xc0, 0xbc, 0x50, 0x08, 0xb2, 0x03,
+	0xab, 0x21, 0xdf, 0xe6, 0x68, 0x9b, 0xf5, 0xdd, 0x4d, 0x11, 0xf6, 0x72, 0x9b, 0xdf, 0x92, 0x44,
+	0xe4, 0x19, 0xac, 0xb9, 0xda, 0xb6, 0x44, 0x93, 0xad, 0xef, 0x6e, 0x8b, 0x4e, 0xb3, 0x3b, 0xd6,
+	0xca, 0x91, 0xb3, 0x08, 0xfe, 0xce, 0x89, 0x03, 0x2f, 0x18, 0x26, 0x9d, 0x2a, 0x86, 0x3a, 0xd5,
+	0x36, 0xbf, 0x00, 0x43, 0xd3, 0xdb, 0x2b, 0x61, 0x6a, 0xef, 0x8b, 0x36, 0xe6, 0x7f, 0x16, 0xe0,
+	0xfa, 0xdc, 0x7e, 0x42, 0xe3, 0x2f, 0xa1, 0x2a, 0xcd, 0x56, 0x44, 0xf6, 0xc7, 0x42, 0xda, 0x0b,
+	0x7a, 0xed, 0x48, 0xc0, 0xf3, 0x20, 0x8d, 0x27, 0x96, 0xe2, 0xc0, 0x7c, 0xb4, 0x96, 0x7e, 0xe0,
+	0xb7, 0xb6, 0x76, 0xa5, 0x39, 0x6b, 0x57, 0x96, 0x6b, 0x67, 0x7c, 0x0d, 0x8d, 0x1c, 0x5b, 0xe6,
+	0xdc, 0xdf, 0xd2, 0x89, 0x98, 0x0f, 0xfb, 0x64, 0x59, 0xcc, 0x99, 0xe3, 0x8f, 0x25, 0x7f, 0xde,
+	0xf8, 0xaa, 0xf8, 0xb4, 0x60, 0xee, 0x6a, 0x01, 0xf0, 0x45, 0x72, 0x92, 0x68, 0x41, 0x6c, 0xa1,
+	0x6a, 0x7e, 0x01, 0x9d, 0xd9, 0x3e, 0x42, 0x2d, 0x9b, 0x50, 0x19, 0x33, 0x80, 0xe8, 0xc2, 0x1b,
+	0x97, 0x8e, 0x1c, 0xdf, 0x69, 0x9c, 0x8f, 0x28, 0xf7, 0xd9, 0xef, 0xcd, 0x0b, 0x08, 0x94, 0x71,
+	0xe7, 0x0b, 0xdd, 0xb1, 0x6f, 0xf3, 0xaf, 0x0a, 0xb0, 0x3d, 0x87, 0x93, 0x10, 0xf2, 0x36, 0xd4,
+	0x7d, 0x6f, 0xe4, 0xa5, 0xf6, 0x9b, 0x49, 0x4a, 0xf9, 0x96, 0x29, 0x5b, 0x80, 0xa0, 0x6f, 0x19,
+	0x84, 0xf9, 0x4f, 0xe6, 0xf0, 0x04, 0xbe, 0xc8, 0xfd, 0x27, 0x83, 0x70, 0xf4, 0x6d, 0xa8, 0x7b,
+	0x41, 0xe8, 0xd2, 0x84, 0xbb, 0xc5, 0x12, 0xef, 0xcf, 0x41, 0xe8, 0x14, 0xb3, 0xf9, 0x96, 0xe7,
+	0xcc, 0xb7, 0xa2, 0xcd, 0x37, 0xcb, 0x82, 0x4e, 0x46, 0xe1, 0x38, 0x78, 0x7f, 0x16, 0xb4, 0x09,
+	0x95, 0x41, 0x18, 0xf7, 0xa9, 0x88, 0x80, 0xbc, 0x91, 0xcb, 0x63, 0x24, 0xa3, 0x25, 0xf3, 0x98,
+	0xc7, 0x70, 0x55, 0xb1, 0x78, 0x75, 0x19, 0x51, 0xcc, 0x9f, 0xc1, 0xb5, 0xe9, 0x1e, 0x4b, 0x8e,
+	0xf9, 0x99, 0xc6, 0x01, 0xfd, 0xe9, 0xfb, 0x8d, 0x4f, 0x9f, 0xa9, 0xec, 0xb2, 0xe4, 0xa8, 0x0f,
+	0xa1, 0xc5, 0x7b, 0xf6, 0x42, 0x57, 0x8e, 0x77, 0x15, 0x56, 0xa2, 0xd0, 0xcd, 0x86, 0xab, 0x44,
+	0xa1, 0xdb, 0x75, 0xcd, 0x5f, 0x42, 0x5b, 0x23, 0x15, 0xe3, 0xdc, 0x02, 0xe8, 0x4b, 0x11, 0x64,
+	0x6a, 0xad, 0x41, 0x2e, 0x6d, 0xed, 0xef, 0x60, 0xb5, 0x17, 0xba, 0x78, 0x34, 0x99, 0x4e, 0xb5,
+	0xe6, 0xf9, 0x83, 0x1b, 0x50, 0x63, 0xff, 0x79, 0x38, 0xe4, 0x2e, 0x21, 0x03, 0xc8, 0x2c, 0x98,
+	0xdb, 0x1b, 0xfb, 0x64, 0xe9, 0xbf, 0x93, 0xa6, 0x74, 0x14, 0xc9, 0xa4, 0x57, 0x36, 0xcd, 0x3f,
+	0x80, 0x4a, 0xf7, 0xe0, 0x95, 0x13, 0x91, 0x8f, 0x60, 0x4d, 0xc9, 0x2d, 0xe7, 0xde, 0xb0, 0xea,
+	0x0a, 0xd6, 0x75, 0xd9, 0x42, 0x9c, 0x86, 0x49, 0xca, 0xb0, 0x5c, 0x83, 0x2b, 0xac, 0xa9, 0x6d,
+	0x3b, 0x3e, 0x1f, 0xbe, 0xed, 0xfe, 0xbb, 0xa8, 0xad, 0x4e, 0x2f, 0xa6, 0x91, 0x13, 0xab, 0x15,
+	0xdd, 0x84, 0x0a, 0x9e, 0xb2, 0xa5, 0x82, 0xb1, 0x21, 0x26, 0x5e, 0x9c, 0x99, 0x78, 0x49, 0x9b,
+	0xf8, 0x47, 0xb0, 0xc6, 0x8e, 0x5d, 0xce, 0x90, 0xda, 0x61, 0x94, 0x26, 0x9d, 0x32, 0x6a, 0xbc,
+	0x2e, 0x60, 0x87, 0x51, 0x9a, 0x90, 0xfb, 0x50, 0x1d, 0x7b, 0xae, 0x3d, 0x72, 0xa2, 0xa4, 0x53,
+	0x41, 0x6f, 0xbc, 0x26, 0xbc, 0x31, 0x4e, 0xd4, 0x5a, 0x1d, 0x7b, 0xee, 0x2b, 0x27, 0x42, 0xc2,
+	0xa1, 0x24, 0x5c, 0x99, 0x47, 0x38, 0x14, 0x84, 0xb7, 0xa1, 0xee, 0x8c, 0xd3, 0xd0, 0xc6, 0x93,
+	0x60, 0x82, 0x01, 0xa9, 0x6a, 0x01, 0x03, 0x9d, 0x20, 0x84, 0x25, 0x1f, 0x1a, 0x01, 0x4f, 0x3e,
+	0xaa, 0xa8, 0x8b, 0x66, 0x46, 0x85, 0xc9, 0xc7, 0x6d, 0xa8, 0xe3, 0x96, 0xb4, 0x7d, 0xe7, 0x0d,
+	0xf5, 0x31, 0x8b, 0xaa, 0x59, 0x80, 0xa0, 0x97, 0x0c, 0xc2, 0xdc, 0x0d, 0xa2, 0xf8, 0xf4, 0x00,
+	0xa7, 0x57, 0x43, 0x08, 0x4e, 0xee, 0x0e, 0x94, 0xa2, 0xd0, 0xc5, 0x64, 0xa9, 0xbe, 0xdb, 0x14,
+	0xe2, 0x0a, 0xcb, 0xb1, 0x18, 0xca, 0xfc, 0xcb, 0x22, 0x74, 0x66, 0xf5, 0x9e, 0x79, 0x3b, 0x3e,
+	0x7c, 0x14, 0x7a, 0x41, 0xda, 0x29, 0x68, 0xc3, 0xf7, 0x18, 0x84, 0x0d, 0x8f, 0x8b, 0x61, 0xf7,
+	0xc3, 0x60, 0x20, 0xd6, 0xa2, 0x86, 0x90, 0xfd, 0x30, 0x18, 0x5c, 0x36, 0x0e, 0xfd, 0xdf, 0xac,
+	0x81, 0xae, 0xb8, 0xd5, 0x19, 0xc5, 0xf1, 0x9c, 0xbf, 0x4f, 0x93, 0x44, 0x90, 0x54, 0x79, 0x5e,
+	0x2d, 0x80, 0x48, 0x64, 0xee, 0xc0, 0x55, 0x76, 0x2e, 0x57, 0xfa, 0x49, 0xde, 0xb3, 0xe7, 0xff,
+	0xac, 0x08, 0x0d, 0x45, 0x3c, 0x77, 0x77, 0x6e, 0x42, 0x05, 0x37, 0x5e, 0xa7, 0x88, 0x4b, 0xc5,
+	0x1b, 0x64, 0x1b, 0xaa, 0x5c, 0x8d, 0x9e, 0x2c, 0xcb, 0xac, 0x62, 0xbb, 0xeb, 0x66, 0x1a, 0xd6,
+	0xb2, 0x2b, 0xae, 0x61, 0x4c, 0xae, 0xb6, 0xa1, 0xea, 0x3b, 0x13, 0xbe, 0x05, 0x79, 0x4a, 0xb5,
+	0x8a, 0xed, 0x2e, 0x6e, 0x62, 0x9c, 0xaf, 0x48, 0xf2, 0xab, 0x96, 0x6c, 0x32, 0x9e, 0xfd, 0x98,
+	0x3a, 0x2c, 0x83, 0x74, 0x52, 0xd4, 0x4d, 0xc9, 0xaa, 0x09, 0xc8, 0x5e, 0x3a, 0xad, 0xbb, 0xea,
+	0x8c, 0xee, 0x84, 0x55, 0xd5, 0x16, 0x5b, 0xd5, 0xff, 0x14, 0xe0, 0xda, 0xb4, 0xe6, 0x84, 0x4d,
+	0xbd, 0x9a, 0x71, 0x81, 0xf5, 0xdd, 0x4f, 0x64, 0x65, 0x63, 0x6e, 0x97, 0x9d, 0x0c, 0xc4, 0x93,
+	0x9f, 0x0f, 0xf0, 0x98, 0xe4, 0x11, 0x54, 0xbc, 0x60, 0x10, 0x72, 0x17, 0x90, 0x25, 0x95, 0xb9,
+	0xd5, 0xb2, 0x38, 0x89, 0xf1, 0x0c, 0xd6, 0xa7, 0x86, 0x7c, 0x5f, 0x62, 0x54, 0xd5, 0x13, 0xa3,
+	0x5f, 0x42, 0xed, 0xe0, 0xf5, 0x11, 0xdb, 0x00, 0xde, 0xf0, 0x82, 0x4a, 0x8a, 0x01, 0xd5, 0x84,
+	0x3a, 0x71, 0xff, 0x54, 0x59, 0x83, 0x6a, 0xb3, 0x5e, 0x61, 0xc4, 0xd2, 0xfe, 0xa4, 0x53, 0xe2,
+	0xbd, 0x44, 0xd3, 0xfc, 0x8b, 0x02, 0xd4, 0x7b, 0x61, 0x9c, 0xbe, 0x72, 0xa2, 0xc8, 0x0b, 0x86,
+	0xe4, 0xc7, 0x50, 0xc5, 0xda, 0x63, 0x3f, 0xf4, 0x51, 0xba, 0xe6, 0xee, 0xba, 0x5c, 0x10, 0x01,
+	0xb6, 0x14, 0x01, 0x3b, 0x71, 0x65, 0x4e, 0x9b, 0x9d, 0x57, 0x50, 0xf8, 0x8a, 0xd5, 0x50, 0x50,
+	0xc6, 0x9a, 0x1d, 0x10, 0xd1, 0x71, 0x23, 0x45, 0x09, 0x29, 0xaa, 0x0c, 0x80, 0x48, 0xe5, 0xd5,
+	0x23, 0x99, 0xa1, 0xb0, 0x66, 0x37, 0x32, 0xff, 0xb9, 0x00, 0x15, 0x8c, 0xe5, 0x53, 0xc3, 0x38,
+	0xe9, 0xa9, 0xd0, 0x9b, 0x36, 0x8c, 0x93, 0x9e, 0x66, 0xc3, 0x30, 0x0a, 0x71, 0x3a, 0xc6, 0x61,
+	0x18, 0xd2, 0x80, 0x6a, 0x4c, 0x1d, 0x37, 0x0c, 0xfc, 0x89, 0x38, 0x8e, 0xab, 0x36, 0xb9, 0x0f,
+	0xeb, 0x09, 0xf5, 0xbd, 0x60, 0x7c, 0x6e, 0xc7, 0x94, 0x1b, 0x69, 0x19, 0x49, 0x9a, 0x02, 0x6c,
+	0x71, 0x28, 0xf9, 0x12, 0xea, 0x51, 0x1c, 0x46, 0xce, 0xd0, 0xc1, 0xa3, 0x55, 0x05, 0xf5, 0xb3,
+	0x25, 0xf4, 0x83, 0xb2, 0xf6, 0x32, 0xb4, 0xa5, 0xd3, 0x9a, 0xbf, 0x82, 0xf5, 0xd7, 0x32, 0x42,
+	0x1e, 0xa2, 0xee, 0x59, 0x30, 0x41, 0x79, 0x03, 0x9a, 0xbe, 0x0b, 0xe3, 0xb7, 0xa2, 0xd8, 0x50,
+	0x67, 0xb0, 0xd7, 0x1c, 0xc4, 0xb6, 0x23, 0x9f, 0x92, 0x88, 0x4c, 0x55, 0x0b, 0x95, 0xd5, 0xf3,
+	0x5c, 0x85, 0xf2, 0xa2, 0x7e, 0xa7, 0x94, 0xa1, 0xba, 0x51, 0xdf, 0x34, 0x01, 0xba, 0x41, 0xfa,
+	0xff, 0x3e, 0xff, 0x9e, 0x99, 0x50, 0x66, 0x58, 0x05, 0xdc, 0x98, 0xbc, 0x61, 0x3a, 0xd0, 0x38,
+	0x7a, 0xfe, 0x92, 0x4d, 0x4e, 0x48, 0x43, 0xa0, 0xcc, 0xe2, 0x87, 0xac, 0xcd, 0xb0, 0x6f, 0x06,
+	0x8b, 0x43, 0x55, 0x6a, 0xc0, 0x6f, 0x06, 0xc3, 0xd2, 0x88, 0x08, 0x8b, 0xec, 0x9b, 0x0d, 0xe1,
+	0xd3, 0x33, 0xa1, 0xb6, 0x9a, 0xc5, 0x1b, 0xe6, 0x1f, 0x97, 0xe0, 0x3a, 0x8e, 0x70, 0xe4, 0x04,
+	0xee, 0x9b, 0xf0, 0xfc, 0x88, 0xf6, 0xc7, 0xb1, 0x97, 0x4e, 0xd8, 0x5e, 0xa0, 0xe7, 0x29, 0xd9,
+	0x87, 0xb6, 0x4a, 0x1a, 0x6c, 0x69, 0x9e, 0x05, 0x74, 0x02, 0xd7, 0x84, 0x4e, 0xa7, 0x54, 0x66,
+	0xb5, 0x82, 0x3c, 0x20, 0x21, 0xcf, 0xb2, 0xb5, 0x93, 0x2c, 0x8a, 0xb9, 0x63, 0x5e, 0x6e, 0x96,
+	0x6a, 0x45, 0x65, 0xf7, 0xcf, 0xa0, 0x1e, 0x8f, 0x03, 0xdb, 0xc1, 0xfc, 0x39, 0xc6, 0x49, 0xd5,
+	0x77, 0xdb, 0x32, 0x06, 0x28, 0x25, 0x5a, 0xb5, 0x78, 0x1c, 0xec, 0xb1, 0x8c, 0x3a, 0x66, 0xd6,
+	0x22, 0x2d, 0xc7, 0x8e, 0xc3, 0x30, 0x1d, 0x24, 0xd2, 0x5a, 0x24, 0xd8, 0x42, 0x28, 0xf9, 0x14,
+	0x36, 0xd8, 0xf9, 0xde, 0xa7, 0x23, 0x1a, 0xa4, 0x8e, 0x6f, 0x0f, 0xe3, 0x70, 0x2c, 0x02, 0x52,
+	0xc9, 0x22, 0x3a, 0xea, 0x3b, 0xc4, 0xb0, 0x6c, 0x2e, 0x8a, 0xbd, 0x33, 0xcf, 0xa7, 0x43, 0xe5,
+	0x64, 0x35, 0x08, 0x79, 0x0c, 0x9b, 0x09, 0xed, 0xf7, 0xc3, 0x51, 0x64, 0x47, 0x71, 0xc8, 0x0e,
+	0xbf, 0xdc, 0xd6, 0x79, 0x34, 0x22, 0x02, 0xd7, 0xe3, 0x28, 0x66, 0xf5, 0xe6, 0x6f, 0x8b, 0x2c,
+	0xe2, 0x04, 0xe3, 0xf3, 0x5e, 0xe8, 0x8a, 0x55, 0x10, 0x7e, 0xe4, 0x2e, 0x34, 0xfa, 0x28, 0x90,
+	0xcd, 0x22, 0xb4, 0x0a, 0xc6, 0x6b, 0x1c, 0xd8, 0x43, 0x18, 0x79, 0x05, 0xad, 0x44, 0x2c, 0x9a,
+	0xdd, 0xe7, 0xab, 0x26, 0xb4, 0x6b, 0x2a, 0x0f, 0xbb, 0x70, 0x7d, 0xad, 0xf5, 0x64, 0x66, 0xc1,
+	0x57, 0x93, 0x49, 0xd2, 0x4f, 0x7d, 0xee, 0x85, 0xea, 0xbb, 0x0f, 0x75, 0x2e, 0xd3, 0x22, 0xee,
+	0x1c, 0x71, 0x5a, 0xee, 0xa3, 0x65, 0x4f, 0xe3, 0x2b, 0x58, 0xd3, 0x11, 0x4b, 0x1d, 0x31, 0x63,
+	0x20, 0xd9, 0x28, 0xaf, 0xa6, 0x4f, 0xbc, 0x05, 0x2d, 0xd1, 0x13, 0x39, 0x6c, 0x31, 0xcb, 0x61,
+	0x2f, 0xce, 0x79, 0xb5, 0x0c, 0xb7, 0x9c, 0xcf, 0x70, 0xff, 0xb6, 0x0c, 0xad, 0x19, 0xed, 0x7f,
+	0x91, 0x3b, 0xb2, 0xeb, 0x05, 0x86, 0x59, 0xf9, 0xb4, 0xb3, 0xb9, 0xc1, 0xf7, 0xbc, 0x7e, 0x3d,
+	0x20, 0xdb, 0x6c, 0x41, 0xfd, 0x70, 0x68, 0xbb, 0x5e, 0x4c, 0xfb, 0x69, 0x18, 0x4f, 0x64, 0xf9,
+	0xcf, 0x0f, 0x87, 0x07, 0x12, 0x46, 0x3e, 0x05, 0x70, 0x83, 0x04, 0xb3, 0x2b, 0x8f, 0x9f, 0x08,
+	0xb3, 0x6b, 0x00, 0x15, 0x63, 0xac, 0x9a, 0x1b, 0x24, 0x42, 0xd0, 0x27, 0xd0, 0x60, 0x5e, 0xdb,
+	0x1e, 0xf1, 0xf0, 0x20, 0xd3, 0x29, 0xa2, 0xa4, 0x55, 0x91, 0xc3, 0x5a, 0x8b, 0xb2, 0x46, 0x42,
+	0xbe, 0x86, 0x15, 0xf4, 0x99, 0x32, 0xaf, 0xba, 0x3b, 0x33, 0x3f, 0xb1, 0xca, 0x98, 0x02, 0x88,
+	0x45, 0x16, 0x5d, 0xc8, 0xef, 0x42, 0xdd, 0x09, 0x02, 0x56, 0xd6, 0xc7, 0x0d, 0xbd, 0x8a, 0x1c,
+	0x1e, 0x2c, 0xe2, 0xb0, 0x97, 0x91, 0x72, 0x36, 0x7a, 0x67, 0xb2, 0x0b, 0x15, 0xdc, 0xf1, 0x98,
+	0x77, 0xd4, 0x77, 0x6f, 0x5c, 0x64, 0x72, 0x16, 0x27, 0x35, 0xbe, 0x84, 0xba, 0x26, 0xd6, 0x32,
+	0x26, 0x66, 0x7c, 0x03, 0xad, 0x69, 0x79, 0x96, 0x32, 0xd1, 0x8f, 0xa0, 0x86, 0x55, 0xf8, 0xa3,
+	0x88, 0xf6, 0xe7, 0x1f, 0x54, 0xcc, 0x2f, 0xa0, 0x8e, 0x24, 0x2f, 0x3c, 0x3f, 0xa5, 0x31, 0xf9,
+	0x91, 0x4e, 0x94, 0x2d, 0xa7, 0xe2, 0x22, 0xbb, 0xfd, 0x63, 0x81, 0x5f, 0x15, 0x21, 0x42, 0x65,
+	0x9e, 0x8f, 0x60, 0x65, 0x80, 0x7c, 0x44, 0x77, 0xa2, 0x77, 0xe7, 0x23, 0x58, 0x82, 0x82, 0x89,
+	0xd3, 0x67, 0x77, 0x8d, 0x32, 0x45, 0xc1, 0x06, 0x0b, 0xe0, 0x09, 0x33, 0x91, 0x37, 0xd2, 0xe4,
+	0x56, 0x58, 0xf3, 0xdb, 0x09, 0x73, 0x67, 0x2e, 0x4d, 0xfa, 0x34, 0x70, 0xbd, 0x60, 0x28, 0x7c,
+	0xa4, 0x06, 0x59, 0x74, 0x13, 0x82, 0xd1, 0x84, 0x15, 0x40, 0xd0, 0x03, 0x36, 0x2c, 0xde, 0x30,
+	0xff, 0xa9, 0x00, 0x75, 0xed, 0xbe, 0x13, 0xe3, 0x10, 0x4d, 0x52, 0x91, 0x05, 0xe1, 0x37, 0xdb,
+	0x1f, 0x5e, 0x90, 0xd2, 0x98, 0xd5, 0x48, 0x8b, 0x18, 0xed, 0x54, 0x9b, 0xed, 0xd0, 0xd4, 0x1b,
+	0xd1, 0x70, 0xcc, 0x53, 0x90, 0x92, 0x25, 0x9b, 0xfc, 0x50, 0xe7, 0xc4, 0xa9, 0x1d, 0xd1, 0xd8,
+	0x0b, 0xf9, 0xc1, 0xb5, 0xc4, 0x0e, 0x75, 0x4e, 0x9c, 0xf6, 0x10, 0xc4, 0x3a, 0xc7, 0x34, 0x8d,
+	0x3d, 0x9a, 0xa0, 0xac, 0x15, 0x4b, 0x36, 0xc9, 0x23, 0x68, 0xd3, 0x73, 0x2f, 0xb5, 0xc3, 0xc0,
+	0x1e, 0x07, 0xa7, 0x28, 0xdf, 0x44, 0xb8, 0xee, 0x75, 0x86, 0x38, 0x0c, 0x4e, 0x24, 0xd8, 0xfc,
+	0xaf, 0x22, 0x54, 0xba, 0xda, 0x59, 0x33, 0x4b, 0xe3, 0xaf, 0x43, 0x2d, 0xa6, 0x51, 0x68, 0xa7,
+	0xce, 0x50, 0x25, 0x6f, 0x0c, 0x70, 0xec, 0x0c, 0x13, 0x26, 0x1f, 0x22, 0x5d, 0x6f, 0x48, 0x93,
+	0x54, 0x66, 0x70, 0x75, 0x06, 0x3b, 0xe0, 0x20, 0x75, 0x02, 0x2e, 0x63, 0xfd, 0x07, 0xbf, 0xc9,
+	0x5d, 0xee, 0xc2, 0x2a, 0x8b, 0x42, 0x1a, 0xc3, 0xe6, 0x2e, 0x1c, 0x57, 0xa6, 0x2e, 0x1c, 0x3b,
+	0xb0, 0x2a, 0x92, 0x78, 0x11, 0x61, 0x64, 0x93, 0xad, 0x9c, 0x1f, 0x3a, 0x2e, 0x75, 0x45, 0x32,
+	0x2f, 0x5a, 0xe4, 0x1e, 0x94, 0x93, 0x88, 0xf6, 0x3b, 0xb5, 0x05, 0x96, 0x88, 0x58, 0xf2, 0x39,
+	0xd4, 0xb9, 0x46, 0xb8, 0x31, 0x41, 0xce, 0xee, 0xf4, 0x2b, 0x6d, 0x9d, 0x8c, 0xa9, 0xc8, 0x77,
+	0x92, 0x94, 0xd7, 0xb9, 0xea, 0x5c, 0x54, 0x06, 0x90, 0x55, 0xae, 0xc8, 0x0b, 0x02, 0xea, 0x62,
+	0x71, 0xbe, 0x6a, 0x89, 0x96, 0x79, 0xce, 0x2f, 0x42, 0xa5, 0xc9, 0x8b, 0x23, 0xc3, 0x3d, 0x58,
+	0xc1, 0x2d, 0x21, 0x8f, 0x0b, 0x6b, 0xba, 0xa0, 0x96, 0xc0, 0x5d, 0xfa, 0x24, 0xb0, 0x09, 0x15,
+	0x7e, 0xdb, 0xc0, 0x1d, 0x3f, 0x6f, 0x98, 0xdf, 0x03, 0xe1, 0xf3, 0xd6, 0xef, 0x9c, 0x2e, 0xbb,
+	0x57, 0x99, 0xea, 0xcf, 0x68, 0xfc, 0x26, 0x4c, 0xe4, 0x71, 0x40, 0x36, 0xcd, 0x7f, 0x2f, 0xc0,
+	0x46, 0x8e, 0xb1, 0x98, 0x93, 0x99, 0xe7, 0x9c, 0x9f, 0x92, 0xe0, 0xfa, 0x14, 0xca, 0xec, 0x40,
+	0x82, 0x06, 0x56, 0xdf, 0xbd, 0x97, 0x1b, 0x3c, 0xc7, 0x6d, 0x87, 0x9d, 0x5e, 0xb8, 0x2f, 0xc5,
+	0x1e, 0x97, 0x2e, 0x00, 0x3f, 0x81, 0x9a, 0xea, 0xba, 0x94, 0xdb, 0xfb, 0x0a, 0x5a, 0x28, 0x07,
+	0xeb, 0xbd, 0xa4, 0xb2, 0xcc, 0x43, 0x68, 0x6b, 0x7d, 0x85, 0x3e, 0x88, 0x30, 0x45, 0x11, 0xd4,
+	0xd9, 0xf7, 0xa5, 0xab, 0x61, 0xff, 0x52, 0x00, 0x60, 0xd7, 0xc9, 0x22, 0x06, 0xea, 0x7b, 0xa4,
+	0x70, 0xc1, 0x03, 0x88, 0xe2, 0xd4, 0x03, 0x08, 0x02, 0x65, 0x67, 0x9c, 0x9e, 0xca, 0x4c, 0x99,
+	0x7d, 0xb3, 0xa3, 0x0c, 0x3f, 0xaf, 0xd9, 0x8e, 0xeb, 0xc6, 0x34, 0x49, 0xe4, 0xa3, 0x08, 0x0e,
+	0xdd, 0xe3, 0xc0, 0x39, 0x6f, 0x27, 0x2a, 0xf3, 0xde, 0x4e, 0x5c, 0xf2, 0x59, 0xc4, 0x6f, 0x8a,
+	0xd0, 0xea, 0x8d, 0x7d, 0x3f, 0x77, 0x31, 0x7c, 0x59, 0x53, 0xfc, 0x58, 0xcc, 0xa2, 0x98, 0xf3,
+	0x23, 0x99, 0x7a, 0xc4, 0xc4, 0xbe, 0x81, 0x66, 0xc2, 0x43, 0xa9, 0xcc, 0x2e, 0x78, 0x2e, 0xbd,
+	0xb5, 0x20, 0x6a, 0x5b, 0x8d, 0x44, 0x6f, 0x92, 0x87, 0xd0, 0x1e, 0x39, 0xe7, 0xbc, 0xcc, 0xcd,
+	0x1c, 0xb1, 0x9d, 0xd0, 0xbe, 0xf0, 0xc4, 0xcd, 0x91, 0x73, 0x8e, 0xd5, 0xee, 0x1e, 0x2b, 0xa0,
+	0x33, 0xff, 0x71, 0x8d, 0x91, 0x46, 0x4e, 0xec, 0xf8, 0x3e, 0xf5, 0x6d, 0x37, 0x7c, 0x17, 0x30,
+	0x07, 0x94, 0x88, 0x38, 0xb2, 0x39, 0x72, 0xce, 0x7b, 0x02, 0x79, 0x20, 0x71, 0x66, 0x0a, 0x6d,
+	0x4d, 0x07, 0xc2, 0x4a, 0xae, 0x03, 0xaf, 0x7d, 0xd8, 0x31, 0x1d, 0xc8, 0xb5, 0xf5, 0x38, 0xc5,
+	0xe0, 0xd2, 0x0e, 0xc0, 0x80, 0xaa, 0x48, 0xf6, 0x12, 0xb1, 0x15, 0x54, 0xdb, 0xb4, 0x80, 0xf0,
+	0xaa, 0xed, 0x07, 0xe9, 0x7e, 0x7e, 0x85, 0xfd, 0x19, 0x6c, 0xe4, 0x78, 0x2e, 0x59, 0x73, 0xfe,
+	0x12, 0xd6, 0x7b, 0x5e, 0xf0, 0x21, 0xf2, 0xb0, 0x5d, 0x9a, 0x75, 0x5d, 0x72, 0xd8, 0xaf, 0xa1,
+	0x7d, 0x12, 0x44, 0x1f, 0x38, 0xf0, 0xff, 0x07, 0xa2, 0x77, 0x5e, 0x72, 0xe8, 0x4d, 0xe1, 0x8b,
+	0x5f, 0x24, 0x9a, 0x7b, 0x61, 0x8f, 0x57, 0x0e, 0xbc, 0xe4, 0xad, 0x7e, 0xd1, 0x64, 0xfe, 0x5d,
+	0x01, 0x9a, 0x48, 0xaa, 0x30, 0xcb, 0x85, 0x6a, 0xbd, 0x12, 0x5d, 0x12, 0x71, 0xf8, 0x36, 0xd4,
+	0x93, 0x53, 0x87, 0x5d, 0xb3, 0xaa, 0x10, 0x5d, 0xb2, 0x80, 0x83, 0x64, 0x51, 0x76, 0x1c, 0x78,
+	0x3f, 0x8c, 0xc5, 0xb5, 0x71, 0x85, 0x13, 0x70, 0x10, 0x12, 0xe4, 0xab, 0xfc, 0x3c, 0x2b, 0xd2,
+	0x20, 0xe6, 0x9f, 0x14, 0x80, 0xa8, 0x02, 0xd3, 0x62, 0xc9, 0x97, 0xae, 0x15, 0xea, 0xc5, 0xc0,
+	0x72, 0xbe, 0x18, 0x28, 0x27, 0x5a, 0xc9, 0x26, 0x6a, 0x7e, 0x0e, 0xcd, 0x97, 0xce, 0xe4, 0x22,
+	0x09, 0xf4, 0xfb, 0x31, 0xd9, 0xeb, 0xb7, 0x25, 0x68, 0x6b, 0xeb, 0x20, 0x96, 0xf6, 0x93, 0xa9,
+	0x10, 0x7d, 0x55, 0xb7, 0x8c, 0x8c, 0x5c, 0x10, 0x91, 0x2f, 0x73, 0x1a, 0xe2, 0xf1, 0x6d, 0x7b,
+	0xba, 0x24, 0x97, 0x75, 0xd3, 0x88, 0xc9, 0x37, 0xb0, 0xee, 0x3a, 0xc1, 0xd0, 0x67, 0xf7, 0xc9,
+	0x38, 0x3b, 0x79, 0x38, 0x95, 0x43, 0xe6, 0xe7, 0x64, 0x35, 0x25, 0x35, 0xc2, 0xf9, 0x0d, 0x1c,
+	0x0a, 0x91, 0x5b, 0x5e, 0x0e, 0xc2, 0xd5, 0xbb, 0x0f, 0xeb, 0xd9, 0x70, 0xfa, 0x12, 0x67, 0x45,
+	0x2d, 0x4e, 0xf8, 0x18, 0x36, 0xa7, 0x24, 0xe1, 0xd4, 0x2b, 0x48, 0x4d, 0xf2, 0xe3, 0x62, 0x8f,
+	0x87, 0xd0, 0x8a, 0x69, 0xdf, 0x77, 0xbc, 0x11, 0x7b, 0xaa, 0xc0, 0xa9, 0x79, 0xf9, 0x75, 0x5d,
+	0x83, 0x23, 0x69, 0xb6, 0x57, 0xaa, 0x73, 0xf6, 0x4a, 0x4d, 0xed, 0x95, 0xbb, 0x50, 0x3f, 0x59,
+	0x54, 0x3c, 0x2a, 0xcb, 0xe2, 0xd1, 0x7d, 0x68, 0x1f, 0xf1, 0x2b, 0x8f, 0x2e, 0xc6, 0xa3, 0x81,
+	0xc7, 0x8b, 0x45, 0xe3, 0xb1, 0x5a, 0x6e, 0xfc, 0x36, 0xff, 0xb5, 0x00, 0xeb, 0xd9, 0x5d, 0x3c,
+	0x37, 0x8a, 0x1b, 0x50, 0x63, 0x99, 0x77, 0x92, 0x3a, 0xa3, 0x48, 0xd4, 0xa4, 0x32, 0x00, 0x79,
+	0x02, 0x20, 0x6f, 0x58, 0xc4, 0xf9, 0xbb, 0xbe, 0xdb, 0x91, 0xa5, 0x9c, 0xe9, 0x31, 0xad, 0x5a,
+	0x22, 0x41, 0xe4, 0xb3, 0xdc, 0x45, 0x69, 0x29, 0x97, 0x54, 0x9e, 0xe8, 0x95, 0x9c, 0xec, 0xf2,
+	0xf4, 0xa7, 0xf9, 0xcb, 0xd3, 0xf2, 0xc2, 0x3e, 0xda, 0x85, 0xaa, 0xf9, 0x1b, 0x99, 0x80, 0x49,
+	0x6f, 0x22, 0x2c, 0x76, 0x1f, 0xda, 0x7c, 0x1f, 0x65, 0x8f, 0x12, 0xa4, 0xf1, 0xca, 0x6a, 0xd6,
+	0x94, 0x26, 0xac, 0x96, 0x27, 0x0e, 0x59, 0x92, 0xfe, 0xd2, 0x19, 0xca, 0x9f, 0x17, 0x60, 0xe3,
+	0x7b, 0x1a, 0x7b, 0x83, 0x49, 0xfe, 0x34, 0x77, 0xd9, 0xc0, 0x72, 0x0b, 0xe0, 0x87, 0xb1, 0x13,
+	0x3b, 0x41, 0xea, 0x05, 0x32, 0xba, 0x68, 0x10, 0x26, 0x07, 0xbb, 0xba, 0xf1, 0x62, 0x51, 0x5a,
+	0x14, 0x2d, 0x2c, 0x73, 0xc4, 0xfd, 0x53, 0xef, 0x4c, 0x5e, 0x1d, 0xc8, 0xa6, 0xf9, 0xd7, 0xec,
+	0x74, 0xc9, 0x2c, 0x93, 0x8b, 0x65, 0xd1, 0x64, 0xec, 0xa7, 0x33, 0x0e, 0x60, 0x0b, 0x56, 0xd9,
+	0x9b, 0x29, 0x5b, 0x95, 0x56, 0x56, 0x58, 0xb3, 0xeb, 0xb2, 0x2b, 0x2c, 0x56, 0xbb, 0x1a, 0xe3,
+	0x2b, 0x14, 0x41, 0xc1, 0xbd, 0x51, 0x53, 0xc2, 0x0f, 0x38, 0x65, 0x13, 0x8a, 0xe1, 0x5b, 0x71,
+	0x9a, 0x2c, 0x86, 0x6f, 0x99, 0x85, 0xd2, 0x38, 0x0e, 0x63, 0x91, 0x21, 0xf1, 0x06, 0x2f, 0xf7,
+	0x32, 0x91, 0x55, 0x21, 0x4d, 0xb5, 0xcd, 0xff, 0x28, 0x88, 0x84, 0xf1, 0x42, 0x51, 0x2f, 0xf4,
+	0xf3, 0x5c, 0x88, 0x92, 0x12, 0xe2, 0x0e, 0xd4, 0x33, 0xed, 0xb9, 0x42, 0x3a, 0x1d, 0xb4, 0x40,
+	0xcc, 0xc7, 0xac, 0x4a, 0x32, 0xe1, 0x5e, 0xbd, 0xa4, 0x59, 0xfa, 0x8c, 0x26, 0x2d, 0x41, 0x27,
+	0x0e, 0x83, 0x8e, 0x17, 0xdb, 0x9c, 0x1d, 0x3f, 0x99, 0xd5, 0x39, 0xec, 0x39, 0x03, 0x99, 0x11,
+	0x6c, 0xe6, 0x6d, 0x43, 0x58, 0xe8, 0xe3, 0x29, 0x9f, 0xda, 0xd1, 0xad, 0x23, 0x3f, 0xd8, 0x72,
+	0x47, 0x20, 0xf3, 0x2d, 0xac, 0x1f, 0x3b, 0xc3, 0x5c, 0x64, 0x7f, 0x04, 0xab, 0x49, 0xdc, 0x7f,
+	0xed, 0x8c, 0x16, 0xdb, 0xa2, 0x24, 0x20, 0x3f, 0x81, 0xaa, 0x4b, 0x93, 0xf4, 0xb5, 0x2c, 0x6b,
+	0xcd, 0x23, 0x56, 0x14, 0x2c, 0x09, 0xc9, 0x06, 0x5b, 0x2e, 0x13, 0x78, 0x74, 0x03, 0xaa, 0xf2,
+	0x1a, 0x83, 0xac, 0x42, 0xe9, 0x78, 0xbf, 0xd7, 0xba, 0xc2, 0x3e, 0x4e, 0x0e, 0x7a, 0xad, 0xc2,
+	0xa3, 0x11, 0xb4, 0xa6, 0x8b, 0xf8, 0x64, 0x0b, 0x36, 0x7a, 0xd6, 0x61, 0x6f, 0xef, 0xbb, 0xbd,
+	0xe3, 0xee, 0xe1, 0x6b, 0xbb, 0x67, 0x75, 0xbf, 0xdf, 0x3b, 0x7e, 0xde, 0xba, 0x42, 0x3e, 0x82,
+	0x9b, 0x3a, 0xe2, 0xe7, 0x87, 0x47, 0xc7, 0xf6, 0xf1, 0xa1, 0xbd, 0x7f, 0xf8, 0xfa, 0x78, 0xaf,
+	0xfb, 0xfa, 0xb9, 0xd5, 0x2a, 0x90, 0x9b, 0xb0, 0xad, 0x93, 0x7c, 0xdb, 0x3d, 0xe8, 0x5a, 0xcf,
+	0xf7, 0xd9, 0xf7, 0xde, 0xcb, 0x56, 0x71, 0xf7, 0x6f, 0xda, 0xb0, 0xc6, 0x27, 0x48, 0x63, 0xf6,
+	0x82, 0x88, 0xec, 0x03, 0x64, 0xa7, 0x55, 0xd2, 0xd1, 0x2e, 0xb1, 0x72, 0xbb, 0xdc, 0xd8, 0x9e,
+	0x83, 0xe1, 0x8a, 0x30, 0xaf, 0x90, 0x17, 0x50, 0xd7, 0x4e, 0x74, 0x64, 0x7b, 0xde, 0x29, 0x8f,
+	0xb3, 0x31, 0x16, 0x1f, 0x00, 0xcd, 0x2b, 0xe4, 0x67, 0xa2, 0x10, 0x85, 0xd7, 0x8e, 0x5b, 0x3a,
+	0xa9, 0x96, 0x44, 0x19, 0x9d, 0x59, 0x84, 0xce, 0x41, 0x65, 0xdc, 0x8a, 0xc3, 0xf4, 0x39, 0xc4,
+	0xe8, 0xcc, 0x22, 0xf4, 0xb9, 0x68, 0x99, 0xae, 0x9a, 0xcb, 0x6c, 0x46, 0x6d, 0x18, 0xf3, 0x50,
+	0x8a, 0xcf, 0x33, 0xa8, 0xca, 0xbc, 0x95, 0x48, 0x67, 0x3c, 0x95, 0x03, 0x1b, 0x5b, 0x33, 0x70,
+	0xd5, 0x7d, 0x1f, 0x20, 0xcb, 0x3e, 0xd5, 0xba, 0xcc, 0x64, 0xb3, 0xc6, 0xf6, 0x1c, 0xcc, 0xcc,
+	0xba, 0xf0, 0xb0, 0x91, 0x5f, 0x97, 0x5c, 0x62, 0x6a, 0x18, 0xf3, 0x50, 0xba, 0x56, 0xb3, 0x04,
+	0x4b, 0x0a, 0x3d, 0x9d, 0xc8, 0x1a, 0x9d, 0x59, 0x84, 0xe2, 0xb0, 0x07, 0x35, 0xf5, 0xd8, 0x57,
+	0x71, 0x98, 0x7e, 0x38, 0x6c, 0x6c, 0xcf, 0x22, 0xc4, 0x6b, 0x5c, 0xf3, 0x0a, 0x79, 0x0a, 0x2b,
+	0xfc, 0x89, 0x2e, 0xd9, 0x54, 0xc2, 0x6a, 0x0f, 0x7d, 0x8d, 0x6b, 0x53, 0xd0, 0xac, 0x67, 0x17,
+	0xd6, 0x74, 0xe7, 0x44, 0xe4, 0x64, 0xe7, 0x44, 0x33, 0xe3, 0xfa, 0x5c, 0x9c, 0x9a, 0xc7, 0x21,
+	0x34, 0xf3, 0x17, 0xbc, 0xe4, 0xc6, 0x82, 0x7b, 0x5f, 0xce, 0xee, 0xe6, 0x85, 0xb7, 0xc2, 0xe6,
+	0x15, 0x72, 0x02, 0xad, 0xe9, 0xa7, 0x0b, 0xe4, 0xd6, 0x74, 0x16, 0x99, 0x7f, 0x4b, 0x62, 0xdc,
+	0x5e, 0x88, 0x57, 0x6c, 0x2d, 0xed, 0xfa, 0x97, 0xdb, 0x27, 0xb9, 0x39, 0xdd, 0x2b, 0xf7, 0xe4,
+	0xc8, 0xb8, 0xb5, 0x08, 0xad, 0x5b, 0x81, 0x7a, 0x0d, 0xa4, 0xd6, 0x70, 0xfa, 0x29, 0x91, 0xd1,
+	0x99, 0x45, 0xe8, 0xda, 0xcb, 0x3f, 0x99, 0x52, 0xda, 0x9b, 0xfb, 0xf6, 0xca, 0xb8, 0xb9, 0x00,
+	0x3b, 0x77, 0x9a, 0xfc, 0xe1, 0xd7, 0xec, 0x34, 0x73, 0x2f, 0xcb, 0x8c, 0x5b, 0x8b, 0xd0, 0x73,
+	0x79, 0xf2, 0x47, 0xf1, 0xb3, 0x3c, 0x73, 0x6f, 0xf6, 0x8d, 0x5b, 0x8b, 0xd0, 0x73, 0x57, 0x59,
+	0xbc, 0x19, 0x9c, 0x5d, 0xe5, 0xfc, 0x03, 0x44, 0xe3, 0xf6, 0x42, 0xbc, 0x62, 0xfb, 0x0b, 0x68,
+	0xcf, 0x3c, 0xf3, 0x23, 0x33, 0xfd, 0xa6, 0x9e, 0x12, 0x1a, 0x77, 0x16, 0x13, 0xe8, 0x9c, 0x67,
+	0x9e, 0xdb, 0x2a, 0xce, 0x8b, 0x9e, 0xb7, 0x1b, 0x77, 0x16, 0x13, 0x28, 0xce, 0x7f, 0x08, 0x1b,
+	0x73, 0x9e, 0x88, 0x92, 0x8f, 0x2e, 0x7a, 0x3e, 0xca, 0xb9, 0x9b, 0xef, 0x7f, 0x61, 0x6a, 0x5e,
+	0x21, 0x9f, 0x43, 0x05, 0x7f, 0x5c, 0x42, 0x36, 0xf4, 0x9f, 0x9a, 0x48, 0x1e, 0x9b, 0x79, 0xa0,
+	0xea, 0xf5, 0x04, 0x56, 0xf8, 0x2f, 0x48, 0x88, 0x46, 0x91, 0xfd, 0x36, 0xc7, 0xb8, 0x3a, 0x05,
+	0xd5, 0xfd, 0x74, 0xf6, 0xb3, 0x97, 0x5c, 0xfc, 0xcc, 0xfd, 0x3c, 0xc6, 0xd8, 0x9e, 0x83, 0xd1,
+	0x77, 0x96, 0xfa, 0xfd, 0x8a, 0xe6, 0x1d, 0xf3, 0xbf, 0x72, 0x31, 0x3a, 0xb3, 0x08, 0xc5, 0xe1,
+	0x25, 0x34, 0x72, 0x3f, 0x49, 0x21, 0xd2, 0x8f, 0xcd, 0xfb, 0x01, 0x8b, 0x71, 0x63, 0x3e, 0x52,
+	0x8f, 0x1b, 0xfa, 0xb5, 0xc7, 0xf6, 0x9c, 0x3a, 0xf9, 0x54, 0xdc, 0x98, 0xf3, 0xab, 0x30, 0x1e,
+	0x03, 0x65, 0xda, 0xa4, 0x62, 0xe0, 0x54, 0xd2, 0x66, 0x6c, 0xcd, 0xc0, 0x65, 0xf7, 0x37, 0x2b,
+	0xf8, 0xe8, 0xe3, 0xa7, 0xff, 0x3b, 0x00, 0xac, 0xe2, 0x15, 0x7d, 0xac, 0x36, 0x00, 0x00,
 }